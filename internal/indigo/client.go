@@ -0,0 +1,507 @@
+// Package indigo implements a minimal client for the WebARena Indigo VPS API,
+// covering only the operations the terraform-provider-webarena resources need.
+package indigo
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+)
+
+const defaultEndpoint = "https://web.arena.ne.jp/indigo/api/v1"
+
+const defaultCircuitBreakerCooldown = 30 * time.Second
+
+// errCircuitOpen is returned instead of making a request while the circuit
+// breaker is open.
+var errCircuitOpen = errors.New("indigo: circuit breaker open: too many recent API failures")
+
+// Client is a thin wrapper around an *http.Client that knows how to talk to
+// the Indigo API and decode its JSON envelopes.
+type Client struct {
+	Endpoint   string
+	APIKey     string
+	HTTPClient *http.Client
+
+	// SSHKeyJSONField overrides the JSON field name used for the SSH key
+	// body, for API gateway deployments that rename it (e.g. to
+	// "public_key"). Defaults to "sshkey".
+	SSHKeyJSONField string
+
+	// PathPrefix is prepended to every request path after Endpoint, for API
+	// gateway deployments that mount the Indigo API under an additional path
+	// segment (e.g. "/my-gateway"). Defaults to "".
+	PathPrefix string
+
+	// LogLevel, when set, is the level (e.g. "DEBUG", "WARN") resource/data
+	// source logging should use for this provider instance, independent of
+	// the ambient TF_LOG. Empty means defer entirely to TF_LOG.
+	LogLevel string
+
+	// RequestTimeout, when > 0, bounds each individual Indigo API request,
+	// as a provider-wide default for callers that don't already set a
+	// deadline on ctx themselves. Zero means no additional bound beyond
+	// whatever the caller's context already carries.
+	RequestTimeout time.Duration
+
+	// CredentialRefresh, when set, is called to obtain a fresh API key once
+	// the current one is older than CredentialTTL. A long-running apply can
+	// outlive a short-lived key, and concurrent requests sharing one Client
+	// must not each kick off their own refresh; credMu coordinates that so
+	// at most one refresh happens at a time and the rest reuse its result.
+	CredentialRefresh func(ctx context.Context) (string, error)
+	CredentialTTL     time.Duration
+
+	// sem bounds the number of in-flight requests, so a large destroy/apply
+	// doesn't overload the Indigo API. nil means unlimited.
+	sem chan struct{}
+
+	// semDestroy additionally bounds the number of in-flight DELETE requests,
+	// on top of whatever sem already allows. Destroys are typically the
+	// riskiest operation to run at high concurrency -- unlike a create/update,
+	// a failed or throttled delete can leave a resource wedged between states
+	// -- so callers may want a tighter cap on destroys specifically than on
+	// throughput in general. nil means no destroy-specific bound beyond sem.
+	semDestroy chan struct{}
+
+	credMu sync.Mutex
+	credAt time.Time
+
+	// ListSSHKeysCacheTTL, when > 0, caches ListSSHKeys results for this long,
+	// so a single plan/apply that reads the account's key list from several
+	// read-heavy data sources (or an sshkey_set reconciling many members)
+	// issues one request instead of one per caller. Any mutation
+	// (create/update/reactivate/delete) invalidates the cache immediately, so
+	// it never serves a result known to be stale. Zero disables caching.
+	ListSSHKeysCacheTTL time.Duration
+
+	// Offline, when true, tells resources to skip the Read API call entirely
+	// and trust the attributes already in state, for plans run against an
+	// unreachable or deliberately firewalled Indigo API (e.g. disconnected
+	// development, or a `terraform plan` run purely to inspect config
+	// changes). It has no effect on Create/Update/Delete, which always need
+	// the API to do anything.
+	Offline bool
+
+	// CircuitBreakerThreshold, when > 0, trips the breaker open after this
+	// many consecutive request failures (transport errors or 5xx
+	// responses), so a large apply against a genuinely down API fails every
+	// remaining operation immediately instead of paying a full timeout for
+	// each one in turn. Zero disables the breaker.
+	CircuitBreakerThreshold int
+
+	// CircuitBreakerCooldown is how long the breaker stays open before
+	// letting the next request through as a probe. Zero means
+	// defaultCircuitBreakerCooldown.
+	CircuitBreakerCooldown time.Duration
+
+	cbMu       sync.Mutex
+	cbFailures int
+	cbOpenedAt time.Time
+
+	listCacheMu sync.Mutex
+	listCacheAt time.Time
+	listCache   []SSHKey
+
+	// UserAgentSuffix, when set, is appended to the User-Agent header this
+	// client sends, so a request log on the Indigo side can attribute calls
+	// to the higher-level tool driving Terraform (e.g. a wrapper CLI or CI
+	// pipeline) rather than just "terraform-provider-webarena".
+	UserAgentSuffix string
+
+	// ReadOnly, when true, rejects every non-GET request before it reaches
+	// the network, for a provider instance wired up purely to read existing
+	// Indigo state (e.g. a dashboard or audit tool built on this provider's
+	// data sources) where a stray resource block must never be allowed to
+	// mutate anything.
+	ReadOnly bool
+
+	// RetryPolicies configures exponential-backoff-with-jitter retries per
+	// error class (see the RetryClass* constants). A class missing from
+	// this map is never retried. Nil disables retrying entirely.
+	RetryPolicies map[string]RetryPolicy
+
+	// RequestHeaders are extra static headers attached to every request, for
+	// API gateway deployments that require e.g. a tenant header or gateway
+	// key in addition to the Indigo API's own Authorization. Entries named
+	// Authorization, Content-Type, Accept, or User-Agent are ignored, since
+	// those are always set by the client itself and silently letting them be
+	// overridden would make auth/decode failures very confusing to diagnose.
+	RequestHeaders map[string]string
+
+	// MaxResponseBytes, when > 0, caps how much of a response body doOnce
+	// will read before failing with errResponseTooLarge. Guards against a
+	// misbehaving endpoint (e.g. a proxy serving an enormous error page)
+	// exhausting memory instead of just returning an unexpected document.
+	MaxResponseBytes int64
+}
+
+// errResponseTooLarge is returned when a response body exceeds
+// Client.MaxResponseBytes.
+var errResponseTooLarge = errors.New("indigo: response body exceeds max_response_bytes")
+
+// reservedRequestHeaders are the headers doOnce always sets itself; entries
+// in Client.RequestHeaders under these names (case-insensitively) are
+// dropped rather than silently overriding what the client needs to send.
+var reservedRequestHeaders = map[string]bool{
+	"Authorization": true,
+	"Content-Type":  true,
+	"Accept":        true,
+	"User-Agent":    true,
+}
+
+// errReadOnly is returned instead of making a request while ReadOnly is set
+// and the request would mutate state.
+var errReadOnly = errors.New("indigo: client is in read-only mode; mutating requests are disabled")
+
+const baseUserAgent = "terraform-provider-webarena"
+
+// userAgent returns the User-Agent header value to send, incorporating
+// UserAgentSuffix if one is set.
+func (c *Client) userAgent() string {
+	if c.UserAgentSuffix == "" {
+		return baseUserAgent
+	}
+	return baseUserAgent + " " + c.UserAgentSuffix
+}
+
+// circuitAllow reports whether a request should be allowed through, tripping
+// the breaker closed again once CircuitBreakerCooldown has elapsed so a
+// recovered API doesn't stay blocked forever.
+func (c *Client) circuitAllow() bool {
+	if c.CircuitBreakerThreshold <= 0 {
+		return true
+	}
+
+	c.cbMu.Lock()
+	defer c.cbMu.Unlock()
+
+	if c.cbFailures < c.CircuitBreakerThreshold {
+		return true
+	}
+
+	cooldown := c.CircuitBreakerCooldown
+	if cooldown <= 0 {
+		cooldown = defaultCircuitBreakerCooldown
+	}
+	return time.Since(c.cbOpenedAt) >= cooldown
+}
+
+// circuitRecord updates the breaker's failure count after a completed
+// request. A success resets the count; a failure increments it and, whenever
+// the count is at or above CircuitBreakerThreshold, restarts the cooldown
+// clock, so a probe that fails after the cooldown reopens the breaker.
+func (c *Client) circuitRecord(success bool) {
+	if c.CircuitBreakerThreshold <= 0 {
+		return
+	}
+
+	c.cbMu.Lock()
+	defer c.cbMu.Unlock()
+
+	if success {
+		c.cbFailures = 0
+		return
+	}
+
+	c.cbFailures++
+	if c.cbFailures >= c.CircuitBreakerThreshold {
+		c.cbOpenedAt = time.Now()
+	}
+}
+
+// currentAPIKey returns the API key to use for the next request, refreshing
+// it first via CredentialRefresh if one is configured and the cached key has
+// exceeded CredentialTTL.
+func (c *Client) currentAPIKey(ctx context.Context) (string, error) {
+	if c.CredentialRefresh == nil {
+		return c.APIKey, nil
+	}
+
+	c.credMu.Lock()
+	defer c.credMu.Unlock()
+
+	if c.APIKey != "" && time.Since(c.credAt) < c.CredentialTTL {
+		return c.APIKey, nil
+	}
+
+	key, err := c.CredentialRefresh(ctx)
+	if err != nil {
+		return "", fmt.Errorf("refresh credentials: %w", err)
+	}
+	c.APIKey = key
+	c.credAt = time.Now()
+	return c.APIKey, nil
+}
+
+// SetTransport overrides the http.RoundTripper used for outgoing requests,
+// e.g. to inject request/response logging, retries, or a test double.
+// There's no schema attribute for this since a RoundTripper isn't
+// expressible in Terraform config; it's for Go code embedding this provider
+// directly (wrapper CLIs, tests) rather than practitioners.
+func (c *Client) SetTransport(rt http.RoundTripper) {
+	if c.HTTPClient == nil || c.HTTPClient == http.DefaultClient {
+		// NewClient starts out on the shared http.DefaultClient; give this
+		// client its own so the transport change stays local to it.
+		c.HTTPClient = &http.Client{}
+	}
+	c.HTTPClient.Transport = rt
+}
+
+// SetMaxConcurrentRequests bounds the number of requests c will have
+// in-flight at once. n <= 0 removes any bound.
+func (c *Client) SetMaxConcurrentRequests(n int) {
+	if n <= 0 {
+		c.sem = nil
+		return
+	}
+	c.sem = make(chan struct{}, n)
+}
+
+// SetMaxConcurrentDestroys bounds the number of DELETE requests c will have
+// in-flight at once, independent of (and in addition to) the general bound
+// set by SetMaxConcurrentRequests. n <= 0 removes any destroy-specific bound.
+func (c *Client) SetMaxConcurrentDestroys(n int) {
+	if n <= 0 {
+		c.semDestroy = nil
+		return
+	}
+	c.semDestroy = make(chan struct{}, n)
+}
+
+// sshKeyJSONField returns c.SSHKeyJSONField, or "sshkey" when unset.
+func (c *Client) sshKeyJSONField() string {
+	if c.SSHKeyJSONField == "" {
+		return "sshkey"
+	}
+	return c.SSHKeyJSONField
+}
+
+// NewClient returns a Client configured against endpoint, or the default
+// Indigo API endpoint when endpoint is empty. When endpoint is empty and
+// region is set, the region-specific endpoint is used instead of the
+// default global one.
+func NewClient(endpoint, apiKey, region string) *Client {
+	if endpoint == "" {
+		endpoint = RegionalEndpoint(region)
+	}
+
+	return &Client{
+		Endpoint:   endpoint,
+		APIKey:     apiKey,
+		HTTPClient: http.DefaultClient,
+	}
+}
+
+// RegionalEndpoint returns the Indigo API endpoint for region, or the
+// default global endpoint when region is empty.
+func RegionalEndpoint(region string) string {
+	if region == "" {
+		return defaultEndpoint
+	}
+	return fmt.Sprintf("https://%s.web.arena.ne.jp/indigo/api/v1", region)
+}
+
+// apiErrorBody is the error envelope returned by the Indigo API.
+type apiErrorBody struct {
+	Message string `json:"message"`
+}
+
+// APIError is returned for any Indigo API response with a 4xx/5xx status
+// code, so callers can inspect StatusCode (e.g. via IsNotFound) instead of
+// pattern-matching on the error string.
+type APIError struct {
+	StatusCode int
+	Message    string
+}
+
+func (e *APIError) Error() string {
+	return fmt.Sprintf("indigo API returned status %d: %s", e.StatusCode, e.Message)
+}
+
+// RedactSecret replaces any occurrence of the current API key in s with a
+// placeholder, so an error message that happens to echo back request
+// details (some gateways include the offending headers in their error
+// pages) never surfaces the credential in a terraform plan/apply log.
+func (c *Client) RedactSecret(s string) string {
+	if c.APIKey == "" {
+		return s
+	}
+	return strings.ReplaceAll(s, c.APIKey, "***REDACTED***")
+}
+
+// looksLikeHTML reports whether body appears to be an HTML document rather
+// than the JSON the Indigo API normally returns. This shows up when endpoint
+// or path_prefix is misconfigured and requests land on a load balancer error
+// page, a login portal, or similar instead of the API.
+func looksLikeHTML(body []byte) bool {
+	s := strings.ToLower(strings.TrimSpace(string(body)))
+	return strings.HasPrefix(s, "<!doctype html") || strings.HasPrefix(s, "<html")
+}
+
+// IsNotFound reports whether err is an APIError for an HTTP 404 response.
+func IsNotFound(err error) bool {
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) {
+		return false
+	}
+	return apiErr.StatusCode == http.StatusNotFound
+}
+
+func (c *Client) do(ctx context.Context, method, path string, body any, out any) error {
+	if c.sem != nil {
+		select {
+		case c.sem <- struct{}{}:
+			defer func() { <-c.sem }()
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+
+	if method == http.MethodDelete && c.semDestroy != nil {
+		select {
+		case c.semDestroy <- struct{}{}:
+			defer func() { <-c.semDestroy }()
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+
+	if !c.circuitAllow() {
+		return errCircuitOpen
+	}
+
+	// ValidateSSHKeyParams' POST only checks params and changes nothing, so
+	// it stays available alongside GETs.
+	if c.ReadOnly && method != http.MethodGet && path != "/sshkeys/validate" {
+		return errReadOnly
+	}
+
+	attempt := 0
+	for {
+		err := c.doOnceWithTimeout(ctx, method, path, body, out)
+		if err == nil {
+			return nil
+		}
+
+		class := classifyError(err)
+		policy, retryable := c.RetryPolicies[class]
+		if class == "" || !retryable || attempt >= policy.MaxAttempts {
+			return err
+		}
+
+		delay := backoffWithJitter(policy, attempt+1)
+		select {
+		case <-time.After(delay):
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+		attempt++
+	}
+}
+
+// doOnceWithTimeout wraps doOnce with a fresh RequestTimeout deadline for
+// this attempt alone, rather than one deadline shared across the whole
+// retry loop in do. A deadline set once before the loop would let a single
+// hung attempt consume the entire budget -- ctx.Done() firing in the
+// backoff select above before any retry ever ran -- so each attempt instead
+// gets its own independent RequestTimeout, regardless of how many attempts
+// came before it.
+func (c *Client) doOnceWithTimeout(ctx context.Context, method, path string, body any, out any) error {
+	if c.RequestTimeout <= 0 {
+		return c.doOnce(ctx, method, path, body, out)
+	}
+	attemptCtx, cancel := context.WithTimeout(ctx, c.RequestTimeout)
+	defer cancel()
+	return c.doOnce(attemptCtx, method, path, body, out)
+}
+
+func (c *Client) doOnce(ctx context.Context, method, path string, body any, out any) error {
+	var reqBody io.Reader
+	if body != nil {
+		b, err := json.Marshal(body)
+		if err != nil {
+			return fmt.Errorf("json.Marshal: %w", err)
+		}
+		reqBody = bytes.NewReader(b)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, c.Endpoint+c.PathPrefix+path, reqBody)
+	if err != nil {
+		return fmt.Errorf("http.NewRequestWithContext: %w", err)
+	}
+
+	for name, value := range c.RequestHeaders {
+		if reservedRequestHeaders[http.CanonicalHeaderKey(name)] {
+			continue
+		}
+		req.Header.Set(name, value)
+	}
+
+	apiKey, err := c.currentAPIKey(ctx)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Authorization", "Bearer "+apiKey)
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set("User-Agent", c.userAgent())
+
+	resp, err := c.HTTPClient.Do(req)
+	if err != nil {
+		c.circuitRecord(false)
+		return fmt.Errorf("c.HTTPClient.Do: %w", err)
+	}
+	defer resp.Body.Close()
+	c.circuitRecord(resp.StatusCode < http.StatusInternalServerError)
+
+	var bodyReader io.Reader = resp.Body
+	if c.MaxResponseBytes > 0 {
+		// Read one byte past the limit so an exactly-at-limit body isn't
+		// mistaken for an oversized one, without ever buffering more than
+		// MaxResponseBytes+1 bytes regardless of how large the real body is.
+		bodyReader = io.LimitReader(resp.Body, c.MaxResponseBytes+1)
+	}
+
+	respBody, err := io.ReadAll(bodyReader)
+	if err != nil {
+		return fmt.Errorf("io.ReadAll: %w", err)
+	}
+	if c.MaxResponseBytes > 0 && int64(len(respBody)) > c.MaxResponseBytes {
+		return errResponseTooLarge
+	}
+
+	if resp.StatusCode >= http.StatusBadRequest {
+		var body apiErrorBody
+		switch {
+		case looksLikeHTML(respBody):
+			body.Message = "endpoint returned an HTML page instead of JSON; check that endpoint and path_prefix point at the Indigo API and not a proxy/login page"
+		default:
+			_ = json.Unmarshal(respBody, &body)
+			if body.Message == "" {
+				body.Message = string(respBody)
+			}
+		}
+		return &APIError{StatusCode: resp.StatusCode, Message: c.RedactSecret(body.Message)}
+	}
+
+	if out == nil || len(respBody) == 0 {
+		return nil
+	}
+
+	if err := json.Unmarshal(respBody, out); err != nil {
+		if looksLikeHTML(respBody) {
+			return fmt.Errorf("endpoint returned an HTML page instead of JSON for a %d response; check that endpoint and path_prefix point at the Indigo API", resp.StatusCode)
+		}
+		return fmt.Errorf("json.Unmarshal: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,94 @@
+package indigo
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// TestCircuitBreakerOpensAfterThreshold confirms that once
+// CircuitBreakerThreshold consecutive failures occur, further requests fail
+// immediately with errCircuitOpen instead of each paying their own round
+// trip to a server that's already known to be down.
+func TestCircuitBreakerOpensAfterThreshold(t *testing.T) {
+	var requests int32
+	client, _ := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+		w.WriteHeader(http.StatusInternalServerError)
+	})
+	client.CircuitBreakerThreshold = 2
+	client.CircuitBreakerCooldown = time.Minute
+
+	for i := 0; i < 2; i++ {
+		if _, err := client.GetSSHKey(context.Background(), "1"); err == nil {
+			t.Fatalf("request %d: GetSSHKey succeeded, want a 500 error", i)
+		}
+	}
+
+	_, err := client.GetSSHKey(context.Background(), "1")
+	if !errors.Is(err, errCircuitOpen) {
+		t.Fatalf("GetSSHKey error = %v, want errCircuitOpen after %d consecutive failures", err, client.CircuitBreakerThreshold)
+	}
+	if got := atomic.LoadInt32(&requests); got != 2 {
+		t.Fatalf("server saw %d requests, want exactly 2 (the third should have been short-circuited)", got)
+	}
+}
+
+// TestCircuitBreakerClosesAfterCooldown confirms the breaker lets a probe
+// request through again once CircuitBreakerCooldown has elapsed, so a
+// recovered API doesn't stay blocked forever.
+func TestCircuitBreakerClosesAfterCooldown(t *testing.T) {
+	client, _ := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	})
+	client.CircuitBreakerThreshold = 1
+	client.CircuitBreakerCooldown = 20 * time.Millisecond
+
+	if _, err := client.GetSSHKey(context.Background(), "1"); err == nil {
+		t.Fatal("GetSSHKey succeeded, want a 500 error")
+	}
+
+	if _, err := client.GetSSHKey(context.Background(), "1"); !errors.Is(err, errCircuitOpen) {
+		t.Fatalf("GetSSHKey error = %v, want errCircuitOpen immediately after tripping", err)
+	}
+
+	time.Sleep(30 * time.Millisecond)
+
+	if _, err := client.GetSSHKey(context.Background(), "1"); errors.Is(err, errCircuitOpen) {
+		t.Fatal("GetSSHKey still short-circuited after CircuitBreakerCooldown elapsed, want the probe request to go through")
+	}
+}
+
+// TestCircuitBreakerResetsOnSuccess confirms a successful request resets the
+// failure count, so an isolated failure doesn't eventually trip the breaker
+// once enough of them accumulate across unrelated successes.
+func TestCircuitBreakerResetsOnSuccess(t *testing.T) {
+	var fail int32 = 1
+	client, _ := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if atomic.LoadInt32(&fail) == 1 {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"id":"1","name":"k"}`))
+	})
+	client.CircuitBreakerThreshold = 2
+	client.CircuitBreakerCooldown = time.Minute
+
+	if _, err := client.GetSSHKey(context.Background(), "1"); err == nil {
+		t.Fatal("GetSSHKey succeeded, want a 500 error")
+	}
+
+	atomic.StoreInt32(&fail, 0)
+	if _, err := client.GetSSHKey(context.Background(), "1"); err != nil {
+		t.Fatalf("GetSSHKey returned unexpected error on the recovered request: %v", err)
+	}
+
+	atomic.StoreInt32(&fail, 1)
+	if _, err := client.GetSSHKey(context.Background(), "1"); errors.Is(err, errCircuitOpen) {
+		t.Fatal("breaker tripped after only one failure following a success, want the success to have reset the count")
+	}
+}
@@ -0,0 +1,66 @@
+package indigo
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+)
+
+// TestMaxConcurrentDestroys confirms semDestroy bounds DELETE concurrency
+// independently of (and tighter than) max_concurrent_requests: non-DELETE
+// methods are left unbounded by this server, but DeleteSSHKey calls still
+// never exceed the destroy-specific cap.
+func TestMaxConcurrentDestroys(t *testing.T) {
+	var (
+		mu       sync.Mutex
+		inFlight int
+		maxSeen  int
+		release  = make(chan struct{})
+	)
+
+	client, _ := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodDelete {
+			w.WriteHeader(http.StatusOK)
+			return
+		}
+		mu.Lock()
+		inFlight++
+		if inFlight > maxSeen {
+			maxSeen = inFlight
+		}
+		mu.Unlock()
+
+		<-release
+
+		mu.Lock()
+		inFlight--
+		mu.Unlock()
+		w.WriteHeader(http.StatusNoContent)
+	})
+	client.SetMaxConcurrentDestroys(1)
+
+	const n = 3
+	var wg sync.WaitGroup
+	for i := 0; i < n; i++ {
+		wg.Add(1)
+		go func(id int) {
+			defer wg.Done()
+			_ = client.DeleteSSHKey(context.Background(), strconv.Itoa(id))
+		}(i)
+	}
+
+	// Let the first DELETE claim the slot, then confirm the rest are queued
+	// rather than also in flight before releasing all of them.
+	time.Sleep(50 * time.Millisecond)
+	close(release)
+	wg.Wait()
+
+	mu.Lock()
+	defer mu.Unlock()
+	if maxSeen != 1 {
+		t.Fatalf("max concurrent in-flight DELETEs = %d, want 1", maxSeen)
+	}
+}
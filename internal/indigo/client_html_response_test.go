@@ -0,0 +1,50 @@
+package indigo
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+// TestHTMLErrorResponse confirms an HTML error page from a misconfigured
+// proxy/gateway produces a clear diagnostic naming the HTTP status, instead
+// of the confusing "invalid character '<' looking for beginning of value"
+// a raw JSON-unmarshal error would otherwise surface.
+func TestHTMLErrorResponse(t *testing.T) {
+	client, _ := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/html")
+		w.WriteHeader(http.StatusBadGateway)
+		_, _ = w.Write([]byte("<!DOCTYPE html><html><body>502 Bad Gateway</body></html>"))
+	})
+
+	_, err := client.GetSSHKey(context.Background(), "1")
+	if err == nil {
+		t.Fatal("GetSSHKey succeeded, want an error for an HTML response")
+	}
+	if strings.Contains(err.Error(), "invalid character") {
+		t.Fatalf("GetSSHKey error = %v, want a clear HTML diagnostic instead of a raw JSON-unmarshal error", err)
+	}
+	if !strings.Contains(err.Error(), "HTML") {
+		t.Fatalf("GetSSHKey error = %v, want it to mention the response was HTML", err)
+	}
+}
+
+// TestHTMLSuccessResponse confirms the same detection applies to a 2xx
+// response body that's HTML rather than JSON (e.g. a captive portal), not
+// just error statuses.
+func TestHTMLSuccessResponse(t *testing.T) {
+	client, _ := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/html")
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte("<html><body>please log in</body></html>"))
+	})
+
+	_, err := client.GetSSHKey(context.Background(), "1")
+	if err == nil {
+		t.Fatal("GetSSHKey succeeded, want an error for an HTML response")
+	}
+	if !strings.Contains(err.Error(), "HTML") {
+		t.Fatalf("GetSSHKey error = %v, want it to mention the response was HTML", err)
+	}
+}
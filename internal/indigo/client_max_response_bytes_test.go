@@ -0,0 +1,47 @@
+package indigo
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+// TestMaxResponseBytes confirms a response body exceeding MaxResponseBytes
+// fails with errResponseTooLarge instead of being silently truncated and
+// parsed anyway, or exhausting memory reading an oversized response from a
+// misbehaving endpoint.
+func TestMaxResponseBytes(t *testing.T) {
+	big := strings.Repeat("x", 1024)
+
+	client, _ := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"id":"1","name":"` + big + `"}`))
+	})
+	client.MaxResponseBytes = 64
+
+	_, err := client.GetSSHKey(context.Background(), "1")
+	if err == nil {
+		t.Fatal("GetSSHKey succeeded, want errResponseTooLarge")
+	}
+	if !strings.Contains(err.Error(), errResponseTooLarge.Error()) {
+		t.Fatalf("GetSSHKey error = %v, want it to wrap %v", err, errResponseTooLarge)
+	}
+}
+
+// TestMaxResponseBytes_WithinLimit confirms a response exactly at the limit
+// is not mistaken for an oversized one, since doOnce reads MaxResponseBytes+1
+// bytes specifically to distinguish "exactly at the limit" from "over it".
+func TestMaxResponseBytes_WithinLimit(t *testing.T) {
+	body := `{"id":"1","name":"k"}`
+
+	client, _ := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(body))
+	})
+	client.MaxResponseBytes = int64(len(body))
+
+	if _, err := client.GetSSHKey(context.Background(), "1"); err != nil {
+		t.Fatalf("GetSSHKey returned unexpected error for a response exactly at MaxResponseBytes: %v", err)
+	}
+}
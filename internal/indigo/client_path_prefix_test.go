@@ -0,0 +1,45 @@
+package indigo
+
+import (
+	"context"
+	"net/http"
+	"testing"
+)
+
+// TestPathPrefix confirms PathPrefix is joined directly between Endpoint and
+// each operation's own path, and that an unset PathPrefix behaves exactly as
+// before it existed. PathPrefix is concatenated as-is (see Client.doOnce), so a
+// caller that includes a trailing slash gets a double slash in the request
+// path; that's on the caller, not something this client normalizes away.
+func TestPathPrefix(t *testing.T) {
+	tests := map[string]struct {
+		prefix   string
+		wantPath string
+	}{
+		"none":        {prefix: "", wantPath: "/sshkeys/1"},
+		"with prefix": {prefix: "/webarena/indigo", wantPath: "/webarena/indigo/sshkeys/1"},
+		"trailing slash on prefix is preserved verbatim, not deduped": {
+			prefix:   "/gw/",
+			wantPath: "/gw//sshkeys/1",
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			var gotPath string
+			client, _ := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+				gotPath = r.URL.Path
+				w.Header().Set("Content-Type", "application/json")
+				_, _ = w.Write([]byte(`{"id":"1","name":"k"}`))
+			})
+			client.PathPrefix = tc.prefix
+
+			if _, err := client.GetSSHKey(context.Background(), "1"); err != nil {
+				t.Fatalf("GetSSHKey returned unexpected error: %v", err)
+			}
+			if gotPath != tc.wantPath {
+				t.Fatalf("request path = %q, want %q", gotPath, tc.wantPath)
+			}
+		})
+	}
+}
@@ -0,0 +1,38 @@
+package indigo
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+)
+
+// TestReadOnlyBlocksMutatingRequests confirms ReadOnly rejects every
+// non-GET request before it reaches the server, but still lets GETs
+// through.
+func TestReadOnlyBlocksMutatingRequests(t *testing.T) {
+	var requests int
+	client, _ := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"id":"1","name":"k"}`))
+	})
+	client.ReadOnly = true
+
+	if _, err := client.CreateSSHKey(context.Background(), SSHKeyParams{Name: "k"}); !errors.Is(err, errReadOnly) {
+		t.Fatalf("CreateSSHKey error = %v, want errReadOnly", err)
+	}
+	if err := client.DeleteSSHKey(context.Background(), "1"); !errors.Is(err, errReadOnly) {
+		t.Fatalf("DeleteSSHKey error = %v, want errReadOnly", err)
+	}
+	if requests != 0 {
+		t.Fatalf("server saw %d requests, want 0 (mutating requests must be rejected before they're sent)", requests)
+	}
+
+	if _, err := client.GetSSHKey(context.Background(), "1"); err != nil {
+		t.Fatalf("GetSSHKey returned unexpected error: %v", err)
+	}
+	if requests != 1 {
+		t.Fatalf("server saw %d requests after a GET, want exactly 1 (ReadOnly must not block reads)", requests)
+	}
+}
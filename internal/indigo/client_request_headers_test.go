@@ -0,0 +1,35 @@
+package indigo
+
+import (
+	"context"
+	"net/http"
+	"testing"
+)
+
+// TestRequestHeaders confirms RequestHeaders entries are attached to every
+// outgoing request, but reserved names the client sets itself (case
+// insensitively) are ignored rather than letting a caller accidentally
+// override its own Authorization or User-Agent.
+func TestRequestHeaders(t *testing.T) {
+	var got http.Header
+	client, _ := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		got = r.Header.Clone()
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"id":"1","name":"k"}`))
+	})
+	client.RequestHeaders = map[string]string{
+		"X-Tenant":      "acme",
+		"authorization": "should-be-ignored",
+	}
+
+	if _, err := client.GetSSHKey(context.Background(), "1"); err != nil {
+		t.Fatalf("GetSSHKey returned unexpected error: %v", err)
+	}
+
+	if got.Get("X-Tenant") != "acme" {
+		t.Fatalf("X-Tenant header = %q, want %q", got.Get("X-Tenant"), "acme")
+	}
+	if got.Get("Authorization") == "should-be-ignored" {
+		t.Fatal("RequestHeaders was allowed to override the client's own Authorization header")
+	}
+}
@@ -0,0 +1,71 @@
+package indigo
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func newTestClient(t *testing.T, handler http.HandlerFunc) (*Client, *httptest.Server) {
+	t.Helper()
+	srv := httptest.NewServer(handler)
+	t.Cleanup(srv.Close)
+	return NewClient(srv.URL, "test-api-key", ""), srv
+}
+
+// TestDoOnceWithTimeout_PerAttempt confirms that a RequestTimeout shorter
+// than a single slow handler's response time fails that attempt, but -- now
+// that the deadline is per-attempt rather than shared across the whole
+// retry loop -- a second attempt still gets its own full RequestTimeout
+// rather than inheriting whatever was left over from the first.
+func TestDoOnceWithTimeout_PerAttempt(t *testing.T) {
+	var attempts int32
+
+	client, _ := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		n := atomic.AddInt32(&attempts, 1)
+		if n == 1 {
+			// Slower than RequestTimeout: this attempt should time out.
+			time.Sleep(150 * time.Millisecond)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]any{"id": "1", "name": "k"})
+	})
+	client.RequestTimeout = 50 * time.Millisecond
+	client.RetryPolicies = map[string]RetryPolicy{
+		RetryClassNetwork: {MaxAttempts: 1, BaseDelay: time.Millisecond, MaxDelay: time.Millisecond},
+	}
+
+	_, err := client.GetSSHKey(context.Background(), "1")
+	if err != nil {
+		t.Fatalf("GetSSHKey returned unexpected error after retry: %v", err)
+	}
+	if got := atomic.LoadInt32(&attempts); got != 2 {
+		t.Fatalf("server saw %d attempts, want 2 (one timed out, one retried and succeeded)", got)
+	}
+}
+
+// TestDoOnceWithTimeout_NoRetryStillTimesOut confirms a single slow attempt
+// with no retry policy configured still fails via its own per-attempt
+// deadline, rather than hanging for the handler's full response time.
+func TestDoOnceWithTimeout_NoRetryStillTimesOut(t *testing.T) {
+	client, _ := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		time.Sleep(150 * time.Millisecond)
+		w.WriteHeader(http.StatusOK)
+	})
+	client.RequestTimeout = 30 * time.Millisecond
+
+	start := time.Now()
+	_, err := client.GetSSHKey(context.Background(), "1")
+	elapsed := time.Since(start)
+
+	if err == nil {
+		t.Fatal("GetSSHKey succeeded, want a timeout error")
+	}
+	if elapsed > 120*time.Millisecond {
+		t.Fatalf("GetSSHKey took %s, want it to fail close to RequestTimeout (30ms), not the handler's full 150ms", elapsed)
+	}
+}
@@ -0,0 +1,44 @@
+package indigo
+
+import (
+	"context"
+	"net/http"
+	"testing"
+)
+
+// roundTripperFunc adapts a function to http.RoundTripper.
+type roundTripperFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+// TestSetTransport confirms SetTransport routes every request through the
+// supplied RoundTripper instead of http.DefaultTransport, e.g. for request
+// signing, mTLS, or WAF headers injected outside what the provider's own
+// schema attributes can express.
+func TestSetTransport(t *testing.T) {
+	var gotHeader string
+	client, _ := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		gotHeader = r.Header.Get("X-Injected")
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"id":"1","name":"k"}`))
+	})
+
+	var intercepted bool
+	client.SetTransport(roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		intercepted = true
+		req.Header.Set("X-Injected", "yes")
+		return http.DefaultTransport.RoundTrip(req)
+	}))
+
+	if _, err := client.GetSSHKey(context.Background(), "1"); err != nil {
+		t.Fatalf("GetSSHKey returned unexpected error: %v", err)
+	}
+	if !intercepted {
+		t.Fatal("request did not go through the custom RoundTripper set via SetTransport")
+	}
+	if gotHeader != "yes" {
+		t.Fatalf("server saw X-Injected = %q, want the header the RoundTripper added", gotHeader)
+	}
+}
@@ -0,0 +1,42 @@
+package indigo
+
+import (
+	"crypto/rsa"
+	"fmt"
+
+	"golang.org/x/crypto/ssh"
+)
+
+// Fingerprint computes the SHA256 fingerprint of an OpenSSH authorized-keys
+// formatted public key, in the same "SHA256:..." format ssh-keygen -lf and
+// the Indigo API's own fingerprint field use. Exported so callers (the
+// sshkey resource's config validators, acceptance tests) can compute it
+// locally instead of round-tripping through the API just to sanity-check a
+// key.
+func Fingerprint(publicKey string) (string, error) {
+	parsed, _, _, _, err := ssh.ParseAuthorizedKey([]byte(publicKey))
+	if err != nil {
+		return "", fmt.Errorf("parse public key: %w", err)
+	}
+	return ssh.FingerprintSHA256(parsed), nil
+}
+
+// RSABitLength returns the modulus size in bits of publicKey and ok=true if
+// it is an ssh-rsa key. ok is false (with no error) for any other key type,
+// e.g. ssh-ed25519 or ecdsa, so callers enforcing a minimum RSA size don't
+// have to recognize key types themselves beyond "is this RSA at all".
+func RSABitLength(publicKey string) (bits int, ok bool, err error) {
+	parsed, _, _, _, err := ssh.ParseAuthorizedKey([]byte(publicKey))
+	if err != nil {
+		return 0, false, fmt.Errorf("parse public key: %w", err)
+	}
+	cryptoKey, isCrypto := parsed.(ssh.CryptoPublicKey)
+	if !isCrypto {
+		return 0, false, nil
+	}
+	rsaKey, isRSA := cryptoKey.CryptoPublicKey().(*rsa.PublicKey)
+	if !isRSA {
+		return 0, false, nil
+	}
+	return rsaKey.N.BitLen(), true, nil
+}
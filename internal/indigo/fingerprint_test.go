@@ -0,0 +1,119 @@
+package indigo_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hakadoriya/terraform-provider-webarena/internal/indigo"
+)
+
+// Keys below were generated locally with ssh-keygen and are not used
+// anywhere outside this test; their fingerprints were cross-checked against
+// `ssh-keygen -lf`.
+const (
+	testRSAKey = "ssh-rsa AAAAB3NzaC1yc2EAAAADAQABAAABAQDfpRZZ/H6/RAlEZWJ248F8YZkFcuI+q3o6oVSo/SQgGEnb3vfs4kUTZXykkZGOJ/H9BQPqyn3F+tsBwUDAZidPcbZpNVdyIduHPqObKTO/wlM+3QfExRqb4KvHY1Gmi075LZVkd1t7p/xH05PFj/ynjjsl7s8u1BJ19Cyi8twVcdWJWoMJk1dG7k/xm9uQUMkmicZfMuJNPDMc0oKCeNhj2Sn+BR1SNnv4HrcBHOQ63kBKgdJOYKPhbh6oMfu2sKXp4r8z22YhN2a1NPrvve83/o1xltV6dUJq6KM5qWSNFYV8RL4WxwVwaJjuEdcjl6Rgd4MOZ2XwD1XS1QNfuJuh test-rsa"
+	testRSAFP  = "SHA256:KDkS7f14gWODgoakJQPuIkBr56IBXBHgdcxaOZq9R6U"
+
+	testEd25519Key = "ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIErq1/s/kSwuJ45h8lbAmOvxenAO1lYDKvYjdibSmR7z test-ed25519"
+	testEd25519FP  = "SHA256:isScVv1LRS4uD0xjxN8Rd0DnBmFJuhY1RbXdHyXRqZM"
+
+	testECDSAKey = "ecdsa-sha2-nistp256 AAAAE2VjZHNhLXNoYTItbmlzdHAyNTYAAAAIbmlzdHAyNTYAAABBBH8lUZjJKmqAjkyxO7fMmOT6JUfQmrnFoRBD5lIz3vnyawQUtZUL/8Ib7Q4yOZE3+S/251OVfJ3exvi4o7VQ6So= test-ecdsa"
+	testECDSAFP  = "SHA256:SNcKQ1tN/9oXuh+FXXFrbEhuh7lFG3I1iaPVkTZei7s"
+)
+
+func TestFingerprint(t *testing.T) {
+	tests := map[string]struct {
+		key     string
+		want    string
+		wantErr bool
+	}{
+		"rsa":     {key: testRSAKey, want: testRSAFP},
+		"ed25519": {key: testEd25519Key, want: testEd25519FP},
+		"ecdsa":   {key: testECDSAKey, want: testECDSAFP},
+		"empty": {
+			key:     "",
+			wantErr: true,
+		},
+		"garbage": {
+			key:     "not a key at all",
+			wantErr: true,
+		},
+		"truncated": {
+			// A prefix of testRSAKey, the shape a truncated copy-paste takes.
+			key:     testRSAKey[:len(testRSAKey)/2],
+			wantErr: true,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := indigo.Fingerprint(tc.key)
+			if tc.wantErr {
+				if err == nil {
+					t.Fatalf("Fingerprint(%q) = %q, nil; want an error", tc.key, got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Fingerprint(%q) returned unexpected error: %v", tc.key, err)
+			}
+			if got != tc.want {
+				t.Fatalf("Fingerprint(%q) = %q, want %q", tc.key, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestRSABitLength(t *testing.T) {
+	tests := map[string]struct {
+		key      string
+		wantBits int
+		wantOK   bool
+		wantErr  bool
+	}{
+		"rsa":     {key: testRSAKey, wantBits: 2048, wantOK: true},
+		"ed25519": {key: testEd25519Key, wantOK: false},
+		"ecdsa":   {key: testECDSAKey, wantOK: false},
+		"malformed": {
+			key:     "ssh-rsa not-valid-base64!!",
+			wantErr: true,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			bits, ok, err := indigo.RSABitLength(tc.key)
+			if tc.wantErr {
+				if err == nil {
+					t.Fatalf("RSABitLength(%q) = %d, %v, nil; want an error", tc.key, bits, ok)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("RSABitLength(%q) returned unexpected error: %v", tc.key, err)
+			}
+			if ok != tc.wantOK {
+				t.Fatalf("RSABitLength(%q) ok = %v, want %v", tc.key, ok, tc.wantOK)
+			}
+			if tc.wantOK && bits != tc.wantBits {
+				t.Fatalf("RSABitLength(%q) bits = %d, want %d", tc.key, bits, tc.wantBits)
+			}
+		})
+	}
+}
+
+// TestFingerprintIgnoresTrailingComment confirms that two keys differing
+// only in their comment field (the part after the base64 blob) fingerprint
+// identically, since the comment carries no key material -- the resource's
+// upsert body comparison (sshKeyBodiesMatch) relies on this to avoid
+// treating a re-commented key as a different key.
+func TestFingerprintIgnoresTrailingComment(t *testing.T) {
+	withoutComment := strings.TrimSuffix(testRSAKey, " test-rsa")
+	got, err := indigo.Fingerprint(withoutComment)
+	if err != nil {
+		t.Fatalf("Fingerprint(%q) returned unexpected error: %v", withoutComment, err)
+	}
+	if got != testRSAFP {
+		t.Fatalf("Fingerprint without comment = %q, want %q", got, testRSAFP)
+	}
+}
@@ -0,0 +1,49 @@
+package indigo
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+// TestListSSHKeysDropsMalformedEntries confirms an entry with no id in an
+// otherwise-good list response is dropped rather than surfaced as an
+// unmanageable phantom key, while its well-formed siblings are still
+// returned.
+func TestListSSHKeysDropsMalformedEntries(t *testing.T) {
+	client, _ := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`[{"id":"1","name":"good"},{"name":"missing-id"}]`))
+	})
+
+	keys, err := client.ListSSHKeys(context.Background())
+	if err != nil {
+		t.Fatalf("ListSSHKeys returned unexpected error: %v", err)
+	}
+	if len(keys) != 1 {
+		t.Fatalf("ListSSHKeys returned %d keys, want 1 (the malformed entry dropped): %+v", len(keys), keys)
+	}
+	if keys[0].ID != "1" {
+		t.Fatalf("ListSSHKeys returned id %q, want %q", keys[0].ID, "1")
+	}
+}
+
+// TestListSSHKeysAllMalformedErrors confirms a response where every entry is
+// missing an id errors outright instead of silently returning an empty
+// list, since that distinguishes a corrupt response from a genuinely empty
+// account.
+func TestListSSHKeysAllMalformedErrors(t *testing.T) {
+	client, _ := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`[{"name":"missing-id-1"},{"name":"missing-id-2"}]`))
+	})
+
+	_, err := client.ListSSHKeys(context.Background())
+	if err == nil {
+		t.Fatal("ListSSHKeys succeeded, want an error when every entry is malformed")
+	}
+	if !strings.Contains(err.Error(), "empty id") {
+		t.Fatalf("ListSSHKeys error = %v, want it to mention the empty id", err)
+	}
+}
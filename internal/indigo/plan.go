@@ -0,0 +1,22 @@
+package indigo
+
+import (
+	"context"
+	"fmt"
+)
+
+// Plan is a VPS plan (instance type) offered by Indigo.
+type Plan struct {
+	ID       string `json:"id"`
+	Name     string `json:"name"`
+	MemoryMB int    `json:"memory_mb"`
+}
+
+// ListPlans returns every VPS plan available to the account.
+func (c *Client) ListPlans(ctx context.Context) ([]Plan, error) {
+	var out []Plan
+	if err := c.do(ctx, "GET", "/plans", nil, &out); err != nil {
+		return nil, fmt.Errorf("list plans: %w", err)
+	}
+	return out, nil
+}
@@ -0,0 +1,84 @@
+package indigo
+
+import (
+	"errors"
+	"math/rand"
+	"net"
+	"net/http"
+	"time"
+)
+
+// RetryPolicy configures exponential-backoff-with-jitter retries for one
+// class of request failure. MaxAttempts is the number of retries after the
+// initial attempt; zero disables retrying that class entirely.
+type RetryPolicy struct {
+	MaxAttempts int
+	BaseDelay   time.Duration
+	MaxDelay    time.Duration
+}
+
+// Error classes a RetryPolicy can be registered under in
+// Client.RetryPolicies. Different classes fail for different reasons and
+// often warrant different tuning: a transient network blip can be retried
+// aggressively, while backing off a rate limit too quickly just trips it
+// again.
+const (
+	RetryClassNetwork   = "network"
+	RetryClassServer    = "server_error"
+	RetryClassRateLimit = "rate_limit"
+)
+
+// classifyError maps an error returned by a single request attempt to the
+// RetryPolicies key it should be retried under, or "" if it shouldn't be
+// retried at all (e.g. a 4xx other than 429, which won't succeed on retry).
+func classifyError(err error) string {
+	var apiErr *APIError
+	if errors.As(err, &apiErr) {
+		switch {
+		case apiErr.StatusCode == http.StatusTooManyRequests:
+			return RetryClassRateLimit
+		case apiErr.StatusCode >= http.StatusInternalServerError:
+			return RetryClassServer
+		default:
+			return ""
+		}
+	}
+
+	if errors.Is(err, errReadOnly) || errors.Is(err, errCircuitOpen) {
+		return ""
+	}
+
+	// Transport-level failures (DNS, connection refused, TLS handshake,
+	// timeout, ...) surface as a net.Error, usually inside the *url.Error
+	// http.Client.Do returns. Anything else (e.g. an oversized or
+	// undecodable response body) is not retried.
+	var netErr net.Error
+	if errors.As(err, &netErr) {
+		return RetryClassNetwork
+	}
+	return ""
+}
+
+// backoffWithJitter returns how long to wait before retry attempt n
+// (1-indexed: the delay before the first retry), as a full-jitter
+// exponential backoff bounded by policy.MaxDelay.
+func backoffWithJitter(policy RetryPolicy, attempt int) time.Duration {
+	base := policy.BaseDelay
+	if base <= 0 {
+		base = 200 * time.Millisecond
+	}
+	max := policy.MaxDelay
+	if max <= 0 {
+		max = 30 * time.Second
+	}
+
+	delay := base << uint(attempt-1) // #nosec G115 -- attempt is always small
+	if delay <= 0 || delay > max {
+		delay = max
+	}
+
+	// Full jitter: a uniform random delay between 0 and the computed cap.
+	// This spreads out a thundering herd of retries far better than a fixed
+	// or half-jittered delay would.
+	return time.Duration(rand.Int63n(int64(delay) + 1))
+}
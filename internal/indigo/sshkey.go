@@ -0,0 +1,315 @@
+package indigo
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// SSHKey is a registered SSH key as returned by the Indigo API.
+type SSHKey struct {
+	ID          string            `json:"id"`
+	Name        string            `json:"name"`
+	SSHKey      string            `json:"-"`
+	Comment     string            `json:"comment"`
+	Tags        map[string]string `json:"tags,omitempty"`
+	Fingerprint string            `json:"fingerprint"`
+	Status      string            `json:"status"`
+	CreatedAt   string            `json:"created_at"`
+	UpdatedAt   string            `json:"updated_at"`
+
+	// ServiceID is the VM/service the key is scoped to, for Indigo
+	// deployments that associate SSH keys with a specific service rather
+	// than the account as a whole. Empty when the key isn't scoped to one.
+	ServiceID string `json:"service_id,omitempty"`
+
+	// ExpiresAt is an RFC3339 timestamp after which the key should be
+	// considered expired, or empty if the key has no expiration set.
+	ExpiresAt string `json:"expires_at,omitempty"`
+}
+
+// UnmarshalJSON decodes an SSHKey, reading the key body from the first of
+// the "sshkey", "public_key" and "key" fields present in the response.
+// Client.SSHKeyJSONField is not consulted here (it names the field request
+// bodies use), so a response field under any other name is not read.
+//
+// id is decoded via json.Number rather than directly into the string field,
+// because some Indigo deployments emit it as a bare JSON number rather than
+// a quoted string. json.Number preserves the original digit sequence
+// exactly, so ids too large for int64 (or for float64 to represent without
+// rounding) still survive the round trip intact. json.Number also accepts a
+// quoted JSON string directly (provided it looks like a number), so this one
+// field already tolerates both encodings without a second code path; either
+// way, k.ID above always ends up a plain Go string for the resource/data
+// source layer to hand Terraform.
+func (k *SSHKey) UnmarshalJSON(data []byte) error {
+	type alias SSHKey
+	aux := struct {
+		ID json.Number `json:"id"`
+		*alias
+	}{alias: (*alias)(k)}
+
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	k.ID = aux.ID.String()
+	// Some deployments report status in lowercase ("active" instead of
+	// "ACTIVE"); normalize it so callers can compare against the documented
+	// uppercase constants (ACTIVE, DEACTIVE) without guessing the casing.
+	k.Status = strings.ToUpper(strings.TrimSpace(k.Status))
+	k.CreatedAt = normalizeTimestamp(k.CreatedAt)
+	k.UpdatedAt = normalizeTimestamp(k.UpdatedAt)
+	k.UpdatedAt = reconcileClockSkew(k.CreatedAt, k.UpdatedAt)
+	k.ExpiresAt = normalizeTimestamp(k.ExpiresAt)
+
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+
+	for _, field := range []string{"sshkey", "public_key", "key"} {
+		if v, ok := raw[field]; ok {
+			if err := json.Unmarshal(v, &k.SSHKey); err != nil {
+				return err
+			}
+			break
+		}
+	}
+
+	// Some Indigo deployments omit fingerprint from list/get responses even
+	// though it's always present on create. Since it's cheap and
+	// deterministic to derive from the key body, degrade gracefully by
+	// computing it locally instead of surfacing a confusing empty value.
+	if k.Fingerprint == "" && k.SSHKey != "" {
+		if fp, err := Fingerprint(k.SSHKey); err == nil {
+			k.Fingerprint = fp
+		}
+	}
+
+	return nil
+}
+
+// SSHKeyParams holds the mutable fields of an SSH key, shared by
+// CreateSSHKey and UpdateSSHKey. SSHKey, Comment, and Status may be left
+// zero-valued to leave the corresponding API field unchanged/defaulted.
+type SSHKeyParams struct {
+	Name    string
+	SSHKey  string
+	Comment string
+	Status  string
+	Tags    map[string]string
+
+	// ExpiresAt, when set, is an RFC3339 timestamp after which the key
+	// should be considered expired. The Indigo API stores it but doesn't
+	// enforce anything on it; enforcement is left to whatever's consuming
+	// the key (e.g. a provisioning pipeline checking it before use).
+	ExpiresAt string
+}
+
+func (c *Client) sshKeyBodyRequest(params SSHKeyParams) map[string]any {
+	body := map[string]any{"name": params.Name}
+	if params.SSHKey != "" {
+		body[c.sshKeyJSONField()] = params.SSHKey
+	}
+	if params.Comment != "" {
+		body["comment"] = params.Comment
+	}
+	if params.Status != "" {
+		body["status"] = params.Status
+	}
+	if len(params.Tags) > 0 {
+		body["tags"] = params.Tags
+	}
+	if params.ExpiresAt != "" {
+		body["expires_at"] = params.ExpiresAt
+	}
+	return body
+}
+
+// CreateSSHKey registers a new SSH key and returns the created resource.
+func (c *Client) CreateSSHKey(ctx context.Context, params SSHKeyParams) (*SSHKey, error) {
+	var out SSHKey
+	if err := c.do(ctx, "POST", "/sshkeys", c.sshKeyBodyRequest(params), &out); err != nil {
+		return nil, fmt.Errorf("create sshkey: %w", err)
+	}
+	c.invalidateListSSHKeysCache()
+	return &out, nil
+}
+
+// GetSSHKey fetches a single SSH key by id.
+func (c *Client) GetSSHKey(ctx context.Context, id string) (*SSHKey, error) {
+	var out SSHKey
+	if err := c.do(ctx, "GET", "/sshkeys/"+id, nil, &out); err != nil {
+		return nil, fmt.Errorf("get sshkey %s: %w", id, err)
+	}
+	return &out, nil
+}
+
+// sshKeysPage is one page of a paginated /sshkeys listing. Some Indigo
+// deployments return a bare JSON array for small accounts but switch to this
+// envelope once the result would otherwise be truncated; ListSSHKeys handles
+// both so callers (including `terraform import` working through a data
+// source) never see a partial list.
+type sshKeysPage struct {
+	Items    []SSHKey `json:"items"`
+	NextPage string   `json:"next_page"`
+}
+
+// invalidateListSSHKeysCache drops any cached ListSSHKeys result, so the next
+// call always reflects a mutation that just happened.
+func (c *Client) invalidateListSSHKeysCache() {
+	c.listCacheMu.Lock()
+	defer c.listCacheMu.Unlock()
+	c.listCache = nil
+	c.listCacheAt = time.Time{}
+}
+
+// ListSSHKeys returns every SSH key registered to the account, transparently
+// walking pagination if the API paginates the response rather than handing
+// back a single page. Subject to ListSSHKeysCacheTTL when set.
+func (c *Client) ListSSHKeys(ctx context.Context) ([]SSHKey, error) {
+	if c.ListSSHKeysCacheTTL > 0 {
+		c.listCacheMu.Lock()
+		if c.listCache != nil && time.Since(c.listCacheAt) < c.ListSSHKeysCacheTTL {
+			cached := c.listCache
+			c.listCacheMu.Unlock()
+			return cached, nil
+		}
+		c.listCacheMu.Unlock()
+	}
+
+	keys, err := c.listSSHKeysUncached(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if c.ListSSHKeysCacheTTL > 0 {
+		c.listCacheMu.Lock()
+		c.listCache = keys
+		c.listCacheAt = time.Now()
+		c.listCacheMu.Unlock()
+	}
+	return keys, nil
+}
+
+func (c *Client) listSSHKeysUncached(ctx context.Context) ([]SSHKey, error) {
+	var raw json.RawMessage
+	if err := c.do(ctx, "GET", "/sshkeys", nil, &raw); err != nil {
+		return nil, fmt.Errorf("list sshkeys: %w", err)
+	}
+
+	var flat []SSHKey
+	if err := json.Unmarshal(raw, &flat); err == nil {
+		return dropMalformedSSHKeys(flat)
+	}
+
+	var page sshKeysPage
+	if err := json.Unmarshal(raw, &page); err != nil {
+		return nil, fmt.Errorf("list sshkeys: unrecognized response shape: %w", err)
+	}
+
+	out := page.Items
+	for page.NextPage != "" {
+		var next sshKeysPage
+		if err := c.do(ctx, "GET", "/sshkeys?page="+url.QueryEscape(page.NextPage), nil, &next); err != nil {
+			return nil, fmt.Errorf("list sshkeys: page %q: %w", page.NextPage, err)
+		}
+		out = append(out, next.Items...)
+		page = next
+	}
+	return dropMalformedSSHKeys(out)
+}
+
+// dropMalformedSSHKeys removes entries with an empty Id -- a key with no id
+// can never be fetched, updated, or deleted again, so keeping one in the
+// result would hand callers (list-backed data sources, sshkey_set
+// reconciliation) a phantom entry they can never manage instead of just the
+// keys that are actually usable. Only errors if every entry is malformed,
+// since that points at the whole response being corrupt rather than one bad
+// record in an otherwise-fine account.
+func dropMalformedSSHKeys(keys []SSHKey) ([]SSHKey, error) {
+	out := make([]SSHKey, 0, len(keys))
+	for _, k := range keys {
+		if k.ID == "" {
+			continue
+		}
+		out = append(out, k)
+	}
+	if len(keys) > 0 && len(out) == 0 {
+		return nil, fmt.Errorf("list sshkeys: all %d entries in the response have an empty id", len(keys))
+	}
+	return out, nil
+}
+
+// UpdateSSHKey updates the mutable fields of an existing SSH key. params.SSHKey
+// may be empty, in which case the key body is left unchanged.
+func (c *Client) UpdateSSHKey(ctx context.Context, id string, params SSHKeyParams) (*SSHKey, error) {
+	var out SSHKey
+	if err := c.do(ctx, "PUT", "/sshkeys/"+id, c.sshKeyBodyRequest(params), &out); err != nil {
+		return nil, fmt.Errorf("update sshkey %s: %w", id, err)
+	}
+	c.invalidateListSSHKeysCache()
+	return &out, nil
+}
+
+// SSHKeyValidation is the result of a dry-run validation of SSHKeyParams
+// against the Indigo API, without creating or modifying anything.
+type SSHKeyValidation struct {
+	Valid   bool   `json:"valid"`
+	Message string `json:"message"`
+}
+
+// ValidateSSHKeyParams asks the Indigo API whether params would be accepted
+// by CreateSSHKey/UpdateSSHKey, without actually registering or changing a
+// key. Useful for a plan-time check (e.g. in a data source) that surfaces a
+// malformed key body before apply instead of during it.
+func (c *Client) ValidateSSHKeyParams(ctx context.Context, params SSHKeyParams) (*SSHKeyValidation, error) {
+	var out SSHKeyValidation
+	if err := c.do(ctx, "POST", "/sshkeys/validate", c.sshKeyBodyRequest(params), &out); err != nil {
+		return nil, fmt.Errorf("validate sshkey params: %w", err)
+	}
+	return &out, nil
+}
+
+// FindSSHKeyByName returns the first registered key with the given name, or
+// nil if none matches. Used to support upsert semantics: checking whether a
+// key with the desired name already exists before deciding whether to create
+// a new one or adopt the existing one.
+func (c *Client) FindSSHKeyByName(ctx context.Context, name string) (*SSHKey, error) {
+	keys, err := c.ListSSHKeys(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("find sshkey by name %s: %w", name, err)
+	}
+	for _, key := range keys {
+		if key.Name == name {
+			return &key, nil
+		}
+	}
+	return nil, nil
+}
+
+// ReactivateSSHKey explicitly reactivates a DEACTIVE key, rather than
+// relying on a generic status update, since some Indigo deployments gate
+// reactivation behind its own endpoint with side effects (e.g. re-issuing
+// the key to already-provisioned VPS instances) that a plain field PUT does
+// not trigger.
+func (c *Client) ReactivateSSHKey(ctx context.Context, id string) (*SSHKey, error) {
+	var out SSHKey
+	if err := c.do(ctx, "POST", "/sshkeys/"+id+"/reactivate", nil, &out); err != nil {
+		return nil, fmt.Errorf("reactivate sshkey %s: %w", id, err)
+	}
+	c.invalidateListSSHKeysCache()
+	return &out, nil
+}
+
+// DeleteSSHKey removes an SSH key by id.
+func (c *Client) DeleteSSHKey(ctx context.Context, id string) error {
+	if err := c.do(ctx, "DELETE", "/sshkeys/"+id, nil, nil); err != nil {
+		return fmt.Errorf("delete sshkey %s: %w", id, err)
+	}
+	c.invalidateListSSHKeysCache()
+	return nil
+}
@@ -0,0 +1,78 @@
+package indigo
+
+import "time"
+
+// indigoTimestampLayouts are the timestamp formats observed across Indigo
+// deployments; regional endpoints have been seen emitting both RFC3339 with
+// an explicit JST offset and a bare "YYYY-MM-DD HH:MM:SS" with no offset at
+// all (implicitly JST).
+var indigoTimestampLayouts = []string{
+	time.RFC3339,
+	time.RFC3339Nano,
+	"2006-01-02 15:04:05",
+}
+
+// ParseIndigoTimestamp parses an Indigo API timestamp in whatever timezone it
+// was reported in and re-renders it in UTC RFC3339, so created_at/updated_at
+// don't silently vary depending on which regional endpoint answered the
+// request. Unparseable input is returned unchanged rather than discarded, so
+// a new/unexpected format degrades to "raw passthrough" instead of data loss.
+//
+// Exported so resources outside this package (and external tooling
+// post-processing state) can apply the same normalization to a raw
+// timestamp string, e.g. when reconciling a value read from an import or a
+// config_json-sourced default against what Read will eventually report.
+func ParseIndigoTimestamp(s string) string {
+	if s == "" {
+		return s
+	}
+
+	for _, layout := range indigoTimestampLayouts {
+		loc := time.UTC
+		if layout == "2006-01-02 15:04:05" {
+			// Bare (no offset) timestamps from the Indigo API are implicitly
+			// JST, not UTC.
+			loc = time.FixedZone("JST", 9*60*60)
+		}
+		if t, err := time.ParseInLocation(layout, s, loc); err == nil {
+			return t.UTC().Format(time.RFC3339)
+		}
+	}
+	return s
+}
+
+// normalizeTimestamp is a package-local alias for ParseIndigoTimestamp, kept
+// so existing call sites within this package read naturally without needing
+// to spell out the package-qualified exported name.
+func normalizeTimestamp(s string) string {
+	return ParseIndigoTimestamp(s)
+}
+
+// clockSkewTolerance bounds how far updated_at is allowed to land before
+// created_at before it's treated as a real anomaly rather than clock skew
+// between the regional servers that stamped each field.
+const clockSkewTolerance = 5 * time.Minute
+
+// reconcileClockSkew clamps updatedAt to createdAt when it appears to
+// precede it by no more than clockSkewTolerance, which happens when the
+// create and a near-immediate read are served by regional hosts whose
+// clocks aren't perfectly synced. A gap larger than the tolerance is left
+// alone, since at that point it's more likely a real data issue than skew
+// and silently hiding it would be worse than a confusing value.
+func reconcileClockSkew(createdAt, updatedAt string) string {
+	if createdAt == "" || updatedAt == "" {
+		return updatedAt
+	}
+	created, err := time.Parse(time.RFC3339, createdAt)
+	if err != nil {
+		return updatedAt
+	}
+	updated, err := time.Parse(time.RFC3339, updatedAt)
+	if err != nil {
+		return updatedAt
+	}
+	if gap := created.Sub(updated); gap > 0 && gap <= clockSkewTolerance {
+		return createdAt
+	}
+	return updatedAt
+}
@@ -0,0 +1,19 @@
+package indigo
+
+import (
+	"context"
+	"fmt"
+)
+
+type versionResponse struct {
+	Version string `json:"version"`
+}
+
+// GetAPIVersion returns the Indigo API's reported version string.
+func (c *Client) GetAPIVersion(ctx context.Context) (string, error) {
+	var out versionResponse
+	if err := c.do(ctx, "GET", "/version", nil, &out); err != nil {
+		return "", fmt.Errorf("get API version: %w", err)
+	}
+	return out.Version, nil
+}
@@ -0,0 +1,49 @@
+// Package acctest provides shared wiring for this provider's acceptance
+// tests: a ProtoV6ProviderFactories map for resource.TestCase, a helper for
+// standing up a fake Indigo API server, and a helper for generating the
+// matching provider config block, so tests don't need real WebARena
+// credentials or network access.
+package acctest
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+
+	"github.com/hakadoriya/terraform-provider-webarena/internal/provider"
+)
+
+// ProtoV6ProviderFactories returns the factory map terraform-plugin-testing
+// expects in resource.TestCase.ProtoV6ProviderFactories, wired to a "test"
+// version of this provider.
+func ProtoV6ProviderFactories() map[string]func() (tfprotov6.ProviderServer, error) {
+	return map[string]func() (tfprotov6.ProviderServer, error){
+		"webarena": providerserver.NewProtocol6WithError(provider.New("test")()),
+	}
+}
+
+// NewFakeIndigoServer starts an httptest.Server backed by handler, which
+// should implement whatever subset of the Indigo API the calling test
+// exercises. Tests point the provider's endpoint attribute at the returned
+// server's URL instead of the real WebARena endpoint. Callers are
+// responsible for closing the returned server.
+func NewFakeIndigoServer(handler http.Handler) *httptest.Server {
+	return httptest.NewServer(handler)
+}
+
+// ProviderConfig returns a "webarena" provider config block pointed at
+// endpoint (typically a NewFakeIndigoServer URL) with a dummy api_key, for
+// use as the common prefix of a resource.TestStep's Config. Centralizing
+// this avoids every acceptance test hand-rolling its own provider block and
+// drifting from whatever dummy credential convention the others use.
+func ProviderConfig(endpoint string) string {
+	return fmt.Sprintf(`
+provider "webarena" {
+  endpoint = %q
+  api_key  = "dummy-api-key"
+}
+`, endpoint)
+}
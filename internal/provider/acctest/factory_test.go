@@ -0,0 +1,32 @@
+package acctest_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/hakadoriya/terraform-provider-webarena/internal/provider/acctest"
+)
+
+// TestProtoV6ProviderFactories confirms ProtoV6ProviderFactories and
+// ProviderConfig compose into a working resource.TestCase end to end: the
+// factory-built provider talks to a NewFakeIndigoServer instance configured
+// via ProviderConfig's dummy credentials, with no real WebARena endpoint or
+// api_key involved. This is the minimal scaffolding every other acceptance
+// test in this package builds on.
+func TestProtoV6ProviderFactories(t *testing.T) {
+	srv := acctest.NewFakeIndigoServer(acctest.NewFakeSSHKeyHandler())
+	defer srv.Close()
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: acctest.ProviderConfig(srv.URL) + `
+data "webarena_indigo_sshkeys" "all" {}
+`,
+				Check: resource.TestCheckResourceAttr("data.webarena_indigo_sshkeys.all", "keys.#", "0"),
+			},
+		},
+	})
+}
@@ -0,0 +1,76 @@
+package acctest
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+)
+
+// AssertResourceSchemaStable fetches server's provider schema and confirms
+// that resourceType's top-level attribute set is exactly wantAttributes, no
+// more and no fewer. A plain acceptance test exercising CRUD wouldn't notice
+// an attribute silently dropped (Terraform just stops sending it) or one
+// added without a Computed/Optional/Required decision being deliberate; this
+// turns either into a hard failure that names the exact attributes that
+// changed instead of a sea of test diffs downstream.
+func AssertResourceSchemaStable(ctx context.Context, server tfprotov6.ProviderServer, resourceType string, wantAttributes []string) error {
+	resp, err := server.GetProviderSchema(ctx, &tfprotov6.GetProviderSchemaRequest{})
+	if err != nil {
+		return fmt.Errorf("GetProviderSchema: %w", err)
+	}
+	if len(resp.Diagnostics) > 0 {
+		return fmt.Errorf("GetProviderSchema returned diagnostics: %v", resp.Diagnostics)
+	}
+
+	schema, ok := resp.ResourceSchemas[resourceType]
+	if !ok {
+		return fmt.Errorf("no resource schema registered for %q", resourceType)
+	}
+
+	got := make([]string, 0, len(schema.Block.Attributes))
+	for _, attr := range schema.Block.Attributes {
+		got = append(got, attr.Name)
+	}
+	sort.Strings(got)
+
+	want := append([]string(nil), wantAttributes...)
+	sort.Strings(want)
+
+	if strings.Join(got, ",") != strings.Join(want, ",") {
+		return fmt.Errorf("schema for %q drifted: got attributes %v, want %v", resourceType, got, want)
+	}
+
+	return nil
+}
+
+// SSHKeyResourceAttributes is the attribute set webarena_indigo_sshkey is
+// expected to expose. Passed to AssertResourceSchemaStable; update it
+// alongside any deliberate schema change to resource_sshkey.go.
+var SSHKeyResourceAttributes = []string{
+	"id",
+	"name",
+	"sshkey",
+	"sshkey_wo",
+	"sshkey_wo_version",
+	"sshkey_original",
+	"comment",
+	"tags",
+	"fingerprint",
+	"status",
+	"upsert",
+	"created_at",
+	"updated_at",
+	"treat_deactive_as_deleted",
+	"require_ed25519",
+	"recreate_if_deactive_on_read",
+	"expires_at",
+	"protect_last_active",
+	"min_rsa_bits",
+	"report_drift",
+	"deactivate_on_destroy",
+	"warn_on_key_truncation",
+	"fail_on_existing_name",
+}
@@ -0,0 +1,40 @@
+package acctest_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hakadoriya/terraform-provider-webarena/internal/provider/acctest"
+)
+
+// TestAssertResourceSchemaStable exercises ProtoV6ProviderFactories against
+// the real provider's schema, so an unintentional attribute addition/removal
+// on webarena_indigo_sshkey fails here instead of surfacing downstream as an
+// unexplained acceptance test diff.
+func TestAssertResourceSchemaStable(t *testing.T) {
+	server, err := acctest.ProtoV6ProviderFactories()["webarena"]()
+	if err != nil {
+		t.Fatalf("building provider server: %v", err)
+	}
+
+	if err := acctest.AssertResourceSchemaStable(context.Background(), server, "webarena_indigo_sshkey", acctest.SSHKeyResourceAttributes); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// TestAssertResourceSchemaStable_CatchesDrift confirms the helper actually
+// fails when the wanted attribute set doesn't match, rather than always
+// passing regardless of what's given to it.
+func TestAssertResourceSchemaStable_CatchesDrift(t *testing.T) {
+	server, err := acctest.ProtoV6ProviderFactories()["webarena"]()
+	if err != nil {
+		t.Fatalf("building provider server: %v", err)
+	}
+
+	wantWrong := append([]string(nil), acctest.SSHKeyResourceAttributes...)
+	wantWrong = append(wantWrong, "this_attribute_does_not_exist")
+
+	if err := acctest.AssertResourceSchemaStable(context.Background(), server, "webarena_indigo_sshkey", wantWrong); err == nil {
+		t.Fatal("expected an error for a deliberately wrong attribute set, got nil")
+	}
+}
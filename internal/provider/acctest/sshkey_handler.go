@@ -0,0 +1,184 @@
+package acctest
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// FakeSSHKeyHandler is an in-memory stand-in for the Indigo /sshkeys
+// endpoints, backing NewFakeIndigoServer for tests that exercise a full
+// create/read/update/delete cycle (e.g. rotating an sshkey's body and
+// asserting the resource picks up the new fingerprint) without depending on
+// the real WebARena API.
+type FakeSSHKeyHandler struct {
+	mu     sync.Mutex
+	nextID int
+	clock  int64
+	keys   map[string]map[string]any
+}
+
+// NewFakeSSHKeyHandler returns an empty FakeSSHKeyHandler ready to serve
+// requests via ServeHTTP.
+func NewFakeSSHKeyHandler() *FakeSSHKeyHandler {
+	return &FakeSSHKeyHandler{keys: map[string]map[string]any{}}
+}
+
+// Seed registers a key directly, bypassing ServeHTTP, so a test can arrange
+// for a key to already exist upstream before the provider ever runs (e.g.
+// to exercise a name-collision check) without a prior apply step to create
+// it through. Returns the assigned id.
+func (h *FakeSSHKeyHandler) Seed(name, sshkey string) string {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.nextID++
+	id := strconv.Itoa(h.nextID)
+	stamp := h.tick()
+	h.keys[id] = map[string]any{
+		"id":         id,
+		"name":       name,
+		"sshkey":     sshkey,
+		"status":     "ACTIVE",
+		"created_at": stamp,
+		"updated_at": stamp,
+	}
+	return id
+}
+
+// SeedWithServiceID is Seed plus a service_id, for tests exercising the
+// service_id filter on the sshkeys data source -- the webarena_indigo_sshkey
+// resource itself has no service_id attribute to set one through, since it's
+// assigned by the Indigo API when a key is attached to a VM/service rather
+// than chosen by the caller.
+func (h *FakeSSHKeyHandler) SeedWithServiceID(name, sshkey, serviceID string) string {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.nextID++
+	id := strconv.Itoa(h.nextID)
+	stamp := h.tick()
+	h.keys[id] = map[string]any{
+		"id":         id,
+		"name":       name,
+		"sshkey":     sshkey,
+		"status":     "ACTIVE",
+		"service_id": serviceID,
+		"created_at": stamp,
+		"updated_at": stamp,
+	}
+	return id
+}
+
+// tick advances h's fake clock by one second and returns the new value as an
+// RFC3339 timestamp. A real time.Now() would make created_at == updated_at
+// whenever create and update land in the same wall-clock second, which is
+// common enough against an in-process fake server to make tests asserting
+// updated_at actually changed flaky; an incrementing fake clock instead
+// guarantees every stamped timestamp is strictly later than the last.
+func (h *FakeSSHKeyHandler) tick() string {
+	h.clock++
+	return time.Unix(1700000000+h.clock, 0).UTC().Format(time.RFC3339)
+}
+
+func (h *FakeSSHKeyHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	path := strings.TrimPrefix(r.URL.Path, "/sshkeys")
+	path = strings.Trim(path, "/")
+
+	switch {
+	case r.Method == http.MethodPost && path == "":
+		h.create(w, r)
+	case r.Method == http.MethodGet && path == "":
+		h.list(w)
+	case r.Method == http.MethodGet && path != "":
+		h.get(w, path)
+	case r.Method == http.MethodPut && path != "":
+		h.update(w, r, path)
+	case r.Method == http.MethodDelete && path != "":
+		h.delete(w, path)
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
+
+func (h *FakeSSHKeyHandler) create(w http.ResponseWriter, r *http.Request) {
+	var body map[string]any
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	h.nextID++
+	id := strconv.Itoa(h.nextID)
+	body["id"] = id
+	if _, ok := body["status"]; !ok {
+		body["status"] = "ACTIVE"
+	}
+	stamp := h.tick()
+	body["created_at"] = stamp
+	body["updated_at"] = stamp
+	h.keys[id] = body
+
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(body)
+}
+
+func (h *FakeSSHKeyHandler) list(w http.ResponseWriter) {
+	out := make([]map[string]any, 0, len(h.keys))
+	for _, key := range h.keys {
+		out = append(out, key)
+	}
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(out)
+}
+
+func (h *FakeSSHKeyHandler) get(w http.ResponseWriter, id string) {
+	key, ok := h.keys[id]
+	if !ok {
+		w.WriteHeader(http.StatusNotFound)
+		_ = json.NewEncoder(w).Encode(map[string]any{"message": fmt.Sprintf("sshkey %s not found", id)})
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(key)
+}
+
+// update merges the request body over the stored key, so rotating only the
+// key body (leaving name/comment untouched) behaves like the real API.
+func (h *FakeSSHKeyHandler) update(w http.ResponseWriter, r *http.Request, id string) {
+	key, ok := h.keys[id]
+	if !ok {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+
+	var body map[string]any
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	for k, v := range body {
+		key[k] = v
+	}
+	key["updated_at"] = h.tick()
+	h.keys[id] = key
+
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(key)
+}
+
+func (h *FakeSSHKeyHandler) delete(w http.ResponseWriter, id string) {
+	if _, ok := h.keys[id]; !ok {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+	delete(h.keys, id)
+	w.WriteHeader(http.StatusNoContent)
+}
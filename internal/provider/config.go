@@ -0,0 +1,24 @@
+package provider
+
+import "github.com/hakadoriya/terraform-provider-webarena/internal/indigo"
+
+// ProviderConfig is what gets handed to resources and data sources via
+// resp.ResourceData / resp.DataSourceData. It bundles the API client together
+// with provider-level defaults that individual resources may fall back to,
+// such as DefaultRegion.
+type ProviderConfig struct {
+	Client        *indigo.Client
+	DefaultRegion string
+
+	// DefaultTags are merged into a resource's own tags attribute by
+	// resources that support tags, with the resource's own tags taking
+	// precedence on key conflicts.
+	DefaultTags map[string]string
+
+	// DefaultStatus overrides a resource's schema-level default status
+	// (normally sshKeyDefaultStatus) when the resource's own config omits
+	// status entirely, so a single provider block per environment (e.g.
+	// staging vs. production) can steer newly created keys' default status
+	// without every resource block repeating an explicit one.
+	DefaultStatus string
+}
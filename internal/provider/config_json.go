@@ -0,0 +1,36 @@
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+)
+
+// jsonProviderConfig mirrors the string/region-ish fields of
+// webarenaProviderModel, for bulk configuration via the
+// WEBARENA_INDIGO_CONFIG_JSON environment variable. It's intended for
+// environments (CI runners, wrapper scripts) where exporting one JSON blob
+// is easier than setting half a dozen separate WEBARENA_INDIGO_* variables.
+type jsonProviderConfig struct {
+	Endpoint        string `json:"endpoint"`
+	APIKey          string `json:"api_key"`
+	Region          string `json:"region"`
+	SSHKeyJSONField string `json:"sshkey_json_field"`
+}
+
+// loadJSONProviderConfig parses WEBARENA_INDIGO_CONFIG_JSON if set. An unset
+// or empty variable is not an error; a malformed one is, so a typo surfaces
+// as a Configure-time diagnostic instead of silently falling through to
+// other configuration sources.
+func loadJSONProviderConfig() (jsonProviderConfig, error) {
+	raw := os.Getenv("WEBARENA_INDIGO_CONFIG_JSON")
+	if raw == "" {
+		return jsonProviderConfig{}, nil
+	}
+
+	var cfg jsonProviderConfig
+	if err := json.Unmarshal([]byte(raw), &cfg); err != nil {
+		return jsonProviderConfig{}, fmt.Errorf("parse WEBARENA_INDIGO_CONFIG_JSON: %w", err)
+	}
+	return cfg, nil
+}
@@ -0,0 +1,101 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/hakadoriya/terraform-provider-webarena/internal/indigo"
+)
+
+var (
+	_ datasource.DataSource              = &accountDataSource{}
+	_ datasource.DataSourceWithConfigure = &accountDataSource{}
+)
+
+func NewAccountDataSource() datasource.DataSource {
+	return &accountDataSource{}
+}
+
+// accountDataSource implements the webarena_indigo_account data source,
+// surfacing account-wide information that isn't scoped to any one resource.
+type accountDataSource struct {
+	client *indigo.Client
+}
+
+// accountDataSourceModel maps the account data source schema to a Go struct.
+type accountDataSourceModel struct {
+	ID        types.String `tfsdk:"id"`
+	SSHKeyIDs types.List   `tfsdk:"ssh_key_ids"`
+}
+
+func (d *accountDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_indigo_account"
+}
+
+func (d *accountDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Surfaces account-wide information for the configured Indigo API credentials.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "Synthetic identifier for this data source; always \"account\".",
+			},
+			"ssh_key_ids": schema.ListAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+				Description: "Ids of every SSH key registered to the account.",
+			},
+		},
+	}
+}
+
+func (d *accountDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	config, ok := req.ProviderData.(*ProviderConfig)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *provider.ProviderConfig, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = config.Client
+}
+
+func (d *accountDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data accountDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	keys, err := d.client.ListSSHKeys(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to List SSH Keys", opError("list", "sshkeys", "*", err).Error())
+		return
+	}
+
+	ids := make([]string, 0, len(keys))
+	for _, key := range keys {
+		ids = append(ids, key.ID)
+	}
+
+	idsValue, diags := types.ListValueFrom(ctx, types.StringType, ids)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.ID = types.StringValue("account")
+	data.SSHKeyIDs = idsValue
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,106 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/hakadoriya/terraform-provider-webarena/internal/indigo"
+)
+
+var (
+	_ datasource.DataSource              = &planDataSource{}
+	_ datasource.DataSourceWithConfigure = &planDataSource{}
+)
+
+func NewPlanDataSource() datasource.DataSource {
+	return &planDataSource{}
+}
+
+// planDataSource implements the webarena_indigo_plan data source, which
+// resolves a human-friendly spec (e.g. "2GB") to the numeric plan id the
+// Indigo API expects when provisioning a VPS.
+type planDataSource struct {
+	client *indigo.Client
+}
+
+// planDataSourceModel maps the plan data source schema to a Go struct.
+type planDataSourceModel struct {
+	Spec types.String `tfsdk:"spec"`
+	ID   types.String `tfsdk:"id"`
+	Name types.String `tfsdk:"name"`
+}
+
+func (d *planDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_indigo_plan"
+}
+
+func (d *planDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Resolves a human-friendly VPS plan spec (e.g. \"2GB\") to the plan id the Indigo API expects.",
+		Attributes: map[string]schema.Attribute{
+			"spec": schema.StringAttribute{
+				Required:    true,
+				Description: "Human-friendly plan spec, matched case-insensitively against the plan name (e.g. \"2GB\").",
+			},
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "Identifier of the matching plan.",
+			},
+			"name": schema.StringAttribute{
+				Computed:    true,
+				Description: "Canonical name of the matching plan, as reported by the Indigo API.",
+			},
+		},
+	}
+}
+
+func (d *planDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	config, ok := req.ProviderData.(*ProviderConfig)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *provider.ProviderConfig, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = config.Client
+}
+
+func (d *planDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data planDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plans, err := d.client.ListPlans(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to List Plans", opError("list", "plans", "*", err).Error())
+		return
+	}
+
+	spec := data.Spec.ValueString()
+	for _, plan := range plans {
+		if strings.EqualFold(plan.Name, spec) {
+			data.ID = types.StringValue(plan.ID)
+			data.Name = types.StringValue(plan.Name)
+			resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+			return
+		}
+	}
+
+	resp.Diagnostics.AddError(
+		"No Matching Plan",
+		fmt.Sprintf("No VPS plan found matching spec %q.", spec),
+	)
+}
@@ -0,0 +1,136 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/hakadoriya/terraform-provider-webarena/internal/indigo"
+)
+
+var (
+	_ datasource.DataSource              = &sshKeyDataSource{}
+	_ datasource.DataSourceWithConfigure = &sshKeyDataSource{}
+)
+
+func NewSSHKeyDataSource() datasource.DataSource {
+	return &sshKeyDataSource{}
+}
+
+// sshKeyDataSource implements the webarena_indigo_sshkey data source.
+type sshKeyDataSource struct {
+	client *indigo.Client
+}
+
+// sshKeyDataSourceModel maps the sshkey data source schema to a Go struct.
+type sshKeyDataSourceModel struct {
+	ID            types.String `tfsdk:"id"`
+	AllowNotFound types.Bool   `tfsdk:"allow_not_found"`
+	Name          types.String `tfsdk:"name"`
+	SSHKey        types.String `tfsdk:"sshkey"`
+	Comment       types.String `tfsdk:"comment"`
+	Fingerprint   types.String `tfsdk:"fingerprint"`
+	Status        types.String `tfsdk:"status"`
+	ServiceID     types.String `tfsdk:"service_id"`
+}
+
+func (d *sshKeyDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_indigo_sshkey"
+}
+
+func (d *sshKeyDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Retrieves a single SSH key registered with the WebARena Indigo API by id.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Required:    true,
+				Description: "Identifier assigned by the Indigo API.",
+			},
+			"allow_not_found": schema.BoolAttribute{
+				Optional:    true,
+				Description: "When true, a key that no longer exists upstream resolves to all computed attributes being null instead of failing the read. Defaults to false.",
+			},
+			"name": schema.StringAttribute{
+				Computed:    true,
+				Description: "Name used to identify the key in the Indigo control panel.",
+			},
+			"sshkey": schema.StringAttribute{
+				Computed:    true,
+				Description: "The public key material.",
+			},
+			"comment": schema.StringAttribute{
+				Computed:    true,
+				Description: "Comment associated with the key.",
+			},
+			"fingerprint": schema.StringAttribute{
+				Computed:    true,
+				Description: "Fingerprint of the registered public key.",
+			},
+			"status": schema.StringAttribute{
+				Computed:    true,
+				Description: "Current status of the key as reported by the Indigo API (e.g. ACTIVE, DEACTIVE).",
+			},
+			"service_id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The VM/service the key is scoped to, for Indigo deployments that associate SSH keys with a specific service. Empty when the key isn't scoped to one.",
+			},
+		},
+	}
+}
+
+func (d *sshKeyDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	config, ok := req.ProviderData.(*ProviderConfig)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *provider.ProviderConfig, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = config.Client
+}
+
+func (d *sshKeyDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data sshKeyDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	key, err := d.client.GetSSHKey(ctx, data.ID.ValueString())
+	if err != nil {
+		if data.AllowNotFound.ValueBool() && indigo.IsNotFound(err) {
+			data.Name = types.StringNull()
+			data.SSHKey = types.StringNull()
+			data.Comment = types.StringNull()
+			data.Fingerprint = types.StringNull()
+			data.Status = types.StringNull()
+			data.ServiceID = types.StringNull()
+			resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+			return
+		}
+		resp.Diagnostics.AddError("Unable to Read SSH Key", opError("read", "sshkey", data.ID.ValueString(), err).Error())
+		return
+	}
+	key, ok := requireResponse(&resp.Diagnostics, key, "Unable to Read SSH Key", "read", "sshkey", data.ID.ValueString())
+	if !ok {
+		return
+	}
+
+	data.Name = types.StringValue(key.Name)
+	data.Comment = types.StringValue(key.Comment)
+	data.SSHKey = types.StringValue(key.SSHKey)
+	data.Fingerprint = types.StringValue(key.Fingerprint)
+	data.Status = types.StringValue(key.Status)
+	data.ServiceID = types.StringValue(key.ServiceID)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,154 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/hakadoriya/terraform-provider-webarena/internal/indigo"
+)
+
+var (
+	_ datasource.DataSource              = &sshKeyExportDataSource{}
+	_ datasource.DataSourceWithConfigure = &sshKeyExportDataSource{}
+)
+
+func NewSSHKeyExportDataSource() datasource.DataSource {
+	return &sshKeyExportDataSource{}
+}
+
+// sshKeyExportDataSource implements the webarena_indigo_sshkey_export data
+// source, a migration helper that generates the `terraform import` commands
+// needed to bring every pre-existing SSH key under Terraform management.
+type sshKeyExportDataSource struct {
+	client *indigo.Client
+}
+
+// sshKeyExportDataSourceModel maps the sshkey_export data source schema to a
+// Go struct.
+type sshKeyExportDataSourceModel struct {
+	ResourceNamePrefix types.String `tfsdk:"resource_name_prefix"`
+	ImportCommands     types.List   `tfsdk:"import_commands"`
+	ImportBlocks       types.List   `tfsdk:"import_blocks"`
+	ResourceBlocks     types.List   `tfsdk:"resource_blocks"`
+}
+
+func (d *sshKeyExportDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_indigo_sshkey_export"
+}
+
+func (d *sshKeyExportDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Generates `terraform import` commands for every SSH key currently registered with the Indigo API, to help migrate pre-existing keys into Terraform management.",
+		Attributes: map[string]schema.Attribute{
+			"resource_name_prefix": schema.StringAttribute{
+				Optional:    true,
+				Description: "Prefix used for the generated resource local names (e.g. \"imported\" produces webarena_indigo_sshkey.imported_<id>). Defaults to \"imported\".",
+			},
+			"import_commands": schema.ListAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+				Description: "One `terraform import` command per existing SSH key.",
+			},
+			"import_blocks": schema.ListAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+				Description: "One config-driven `import { ... }` block per existing SSH key (the Terraform 1.5+ import syntax), targeting the same generated resource address as import_commands.",
+			},
+			"resource_blocks": schema.ListAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+				Description: "One `resource \"webarena_indigo_sshkey\" ...` HCL skeleton per existing SSH key, with name and sshkey already populated from the current API read, so that pasting the matching import_blocks entry and this one together produces a plan with no diff.",
+			},
+		},
+	}
+}
+
+func (d *sshKeyExportDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	config, ok := req.ProviderData.(*ProviderConfig)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *provider.ProviderConfig, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = config.Client
+}
+
+func (d *sshKeyExportDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data sshKeyExportDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	prefix := data.ResourceNamePrefix.ValueString()
+	if prefix == "" {
+		prefix = "imported"
+	}
+
+	keys, err := d.client.ListSSHKeys(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to List SSH Keys", opError("list", "sshkeys", "*", err).Error())
+		return
+	}
+
+	commands := make([]string, 0, len(keys))
+	importBlocks := make([]string, 0, len(keys))
+	resourceBlocks := make([]string, 0, len(keys))
+	for _, key := range keys {
+		resourceName := fmt.Sprintf("%s_%s", prefix, key.ID)
+		commands = append(commands, fmt.Sprintf("terraform import webarena_indigo_sshkey.%s %s", resourceName, key.ID))
+		importBlocks = append(importBlocks, fmt.Sprintf(
+			"import {\n  to = webarena_indigo_sshkey.%s\n  id = %s\n}",
+			resourceName, hclQuote(key.ID),
+		))
+		resourceBlocks = append(resourceBlocks, fmt.Sprintf(
+			"resource \"webarena_indigo_sshkey\" %s {\n  name   = %s\n  sshkey = %s\n}",
+			hclQuote(resourceName), hclQuote(key.Name), hclQuote(key.SSHKey),
+		))
+	}
+
+	list, diags := types.ListValueFrom(ctx, types.StringType, commands)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	data.ImportCommands = list
+
+	importBlocksList, diags := types.ListValueFrom(ctx, types.StringType, importBlocks)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	data.ImportBlocks = importBlocksList
+
+	resourceBlocksList, diags := types.ListValueFrom(ctx, types.StringType, resourceBlocks)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	data.ResourceBlocks = resourceBlocksList
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// hclQuote renders s as an HCL double-quoted string literal. strconv.Quote
+// escapes backslashes, double quotes, newlines, carriage returns, and tabs
+// the same way HCL's quoted-string syntax does; the "${" and "%{" template
+// sequences, which strconv.Quote leaves alone, are then doubled so HCL reads
+// them as literal text rather than an interpolation or directive.
+func hclQuote(s string) string {
+	return strings.NewReplacer("${", "$${", "%{", "%%{").Replace(strconv.Quote(s))
+}
@@ -0,0 +1,111 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/hakadoriya/terraform-provider-webarena/internal/indigo"
+)
+
+var (
+	_ datasource.DataSource              = &sshKeyValidateDataSource{}
+	_ datasource.DataSourceWithConfigure = &sshKeyValidateDataSource{}
+)
+
+func NewSSHKeyValidateDataSource() datasource.DataSource {
+	return &sshKeyValidateDataSource{}
+}
+
+// sshKeyValidateDataSource implements the webarena_indigo_sshkey_validate
+// data source: a dry-run check of SSH key params against the Indigo API,
+// without registering anything, so a malformed key body surfaces during
+// `terraform plan` instead of during apply.
+type sshKeyValidateDataSource struct {
+	client *indigo.Client
+}
+
+// sshKeyValidateDataSourceModel maps the sshkey_validate data source schema
+// to a Go struct.
+type sshKeyValidateDataSourceModel struct {
+	Name    types.String `tfsdk:"name"`
+	SSHKey  types.String `tfsdk:"sshkey"`
+	Comment types.String `tfsdk:"comment"`
+	Valid   types.Bool   `tfsdk:"valid"`
+	Message types.String `tfsdk:"message"`
+}
+
+func (d *sshKeyValidateDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_indigo_sshkey_validate"
+}
+
+func (d *sshKeyValidateDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Dry-runs SSH key params against the Indigo API's validation endpoint, without registering a key, so a plan can fail fast on invalid input.",
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				Required:    true,
+				Description: "Name that would be used to identify the key in the Indigo control panel.",
+			},
+			"sshkey": schema.StringAttribute{
+				Required:    true,
+				Description: "The public key material to validate.",
+			},
+			"comment": schema.StringAttribute{
+				Optional:    true,
+				Description: "Comment that would be associated with the key.",
+			},
+			"valid": schema.BoolAttribute{
+				Computed:    true,
+				Description: "Whether the Indigo API considers these params valid.",
+			},
+			"message": schema.StringAttribute{
+				Computed:    true,
+				Description: "Explanation from the Indigo API, populated when valid is false.",
+			},
+		},
+	}
+}
+
+func (d *sshKeyValidateDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	config, ok := req.ProviderData.(*ProviderConfig)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *provider.ProviderConfig, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = config.Client
+}
+
+func (d *sshKeyValidateDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data sshKeyValidateDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	result, err := d.client.ValidateSSHKeyParams(ctx, indigo.SSHKeyParams{
+		Name:    data.Name.ValueString(),
+		SSHKey:  data.SSHKey.ValueString(),
+		Comment: data.Comment.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to Validate SSH Key", opError("validate", "sshkey", data.Name.ValueString(), err).Error())
+		return
+	}
+
+	data.Valid = types.BoolValue(result.Valid)
+	data.Message = types.StringValue(result.Message)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,278 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	stdpath "path"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/hakadoriya/terraform-provider-webarena/internal/indigo"
+)
+
+var (
+	_ datasource.DataSource              = &sshKeysDataSource{}
+	_ datasource.DataSourceWithConfigure = &sshKeysDataSource{}
+)
+
+func NewSSHKeysDataSource() datasource.DataSource {
+	return &sshKeysDataSource{}
+}
+
+// sshKeysDataSource implements the webarena_indigo_sshkeys plural data
+// source, listing every SSH key registered to the account.
+type sshKeysDataSource struct {
+	client *indigo.Client
+}
+
+// sshKeysDataSourceModel maps the sshkeys data source schema to a Go struct.
+type sshKeysDataSourceModel struct {
+	Status        types.String            `tfsdk:"status"`
+	IDs           types.List              `tfsdk:"ids"`
+	StopOnError   types.Bool              `tfsdk:"stop_on_error"`
+	NameFilter    types.String            `tfsdk:"name_filter"`
+	NameContains  types.String            `tfsdk:"name_contains"`
+	ServiceID     types.String            `tfsdk:"service_id"`
+	CreatedAfter  types.String            `tfsdk:"created_after"`
+	CreatedBefore types.String            `tfsdk:"created_before"`
+	Keys          []sshKeyDataSourceModel `tfsdk:"keys"`
+}
+
+func (d *sshKeysDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_indigo_sshkeys"
+}
+
+func (d *sshKeysDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists the SSH keys registered with the WebARena Indigo API.",
+		Attributes: map[string]schema.Attribute{
+			"status": schema.StringAttribute{
+				Optional:    true,
+				Description: "When set, only keys whose status matches this value are returned. Must be ACTIVE or DEACTIVE.",
+			},
+			"ids": schema.ListAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "When set, only the keys with these ids are returned, fetched directly instead of listing the whole account. Mutually exclusive in practice with status, since ids already pins the exact result set.",
+			},
+			"stop_on_error": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Only applies when ids is set. When true (the default), a failure fetching any one id fails the whole read. When false, the failing id is skipped with a warning and the rest are still returned.",
+			},
+			"name_filter": schema.StringAttribute{
+				Optional:    true,
+				Description: "When set, only keys whose name matches this shell-style glob pattern (e.g. \"web-*\") are returned. Applied after status and ids filtering.",
+			},
+			"name_contains": schema.StringAttribute{
+				Optional:    true,
+				Description: "When set, only keys whose name contains this substring are returned. Can be combined with name_filter; both must match.",
+			},
+			"service_id": schema.StringAttribute{
+				Optional:    true,
+				Description: "When set, only keys scoped to this VM/service are returned.",
+			},
+			"created_after": schema.StringAttribute{
+				Optional:    true,
+				Description: "RFC3339 timestamp; when set, only keys created strictly after this time are returned. Compared against each key's created_at after the same timestamp normalization Read applies, so it's robust to the raw format a given Indigo deployment happens to report.",
+			},
+			"created_before": schema.StringAttribute{
+				Optional:    true,
+				Description: "RFC3339 timestamp; when set, only keys created strictly before this time are returned. Must be later than created_after when both are set.",
+			},
+			"keys": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "The matching SSH keys.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:    true,
+							Description: "Identifier assigned by the Indigo API.",
+						},
+						"name": schema.StringAttribute{
+							Computed:    true,
+							Description: "Name used to identify the key in the Indigo control panel.",
+						},
+						"sshkey": schema.StringAttribute{
+							Computed:    true,
+							Description: "The public key material.",
+						},
+						"comment": schema.StringAttribute{
+							Computed:    true,
+							Description: "Comment associated with the key.",
+						},
+						"fingerprint": schema.StringAttribute{
+							Computed:    true,
+							Description: "Fingerprint of the registered public key.",
+						},
+						"status": schema.StringAttribute{
+							Computed:    true,
+							Description: "Current status of the key as reported by the Indigo API (e.g. ACTIVE, DEACTIVE).",
+						},
+						"service_id": schema.StringAttribute{
+							Computed:    true,
+							Description: "The VM/service the key is scoped to. Empty when the key isn't scoped to one.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *sshKeysDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	config, ok := req.ProviderData.(*ProviderConfig)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *provider.ProviderConfig, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = config.Client
+}
+
+func (d *sshKeysDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data sshKeysDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if v := data.Status.ValueString(); v != "" && v != "ACTIVE" && v != "DEACTIVE" {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("status"),
+			"Invalid status Value",
+			fmt.Sprintf("status must be ACTIVE or DEACTIVE, got %q.", v),
+		)
+		return
+	}
+
+	var keys []indigo.SSHKey
+
+	if !data.IDs.IsNull() {
+		var ids []string
+		resp.Diagnostics.Append(data.IDs.ElementsAs(ctx, &ids, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		stopOnError := true
+		if !data.StopOnError.IsNull() {
+			stopOnError = data.StopOnError.ValueBool()
+		}
+
+		for _, id := range ids {
+			key, err := d.client.GetSSHKey(ctx, id)
+			if err != nil {
+				if !stopOnError {
+					resp.Diagnostics.AddWarning("Unable to Read SSH Key", opError("read", "sshkey", id, err).Error())
+					continue
+				}
+				resp.Diagnostics.AddError("Unable to Read SSH Key", opError("read", "sshkey", id, err).Error())
+				return
+			}
+			keys = append(keys, *key)
+		}
+	} else {
+		var err error
+		keys, err = d.client.ListSSHKeys(ctx)
+		if err != nil {
+			resp.Diagnostics.AddError("Unable to List SSH Keys", opError("list", "sshkeys", "*", err).Error())
+			return
+		}
+	}
+
+	nameFilter := data.NameFilter.ValueString()
+	if nameFilter != "" {
+		if _, err := stdpath.Match(nameFilter, ""); err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("name_filter"), "Invalid name_filter Pattern", err.Error())
+			return
+		}
+	}
+
+	var createdAfter, createdBefore time.Time
+	if v := data.CreatedAfter.ValueString(); v != "" {
+		t, err := time.Parse(time.RFC3339, indigo.ParseIndigoTimestamp(v))
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("created_after"), "Invalid created_after Timestamp", err.Error())
+			return
+		}
+		createdAfter = t
+	}
+	if v := data.CreatedBefore.ValueString(); v != "" {
+		t, err := time.Parse(time.RFC3339, indigo.ParseIndigoTimestamp(v))
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("created_before"), "Invalid created_before Timestamp", err.Error())
+			return
+		}
+		createdBefore = t
+	}
+	if !createdAfter.IsZero() && !createdBefore.IsZero() && !createdBefore.After(createdAfter) {
+		resp.Diagnostics.AddError("Invalid created_after/created_before Range", "created_before must be later than created_after.")
+		return
+	}
+
+	status := data.Status.ValueString()
+	serviceID := data.ServiceID.ValueString()
+	nameContains := data.NameContains.ValueString()
+	data.Keys = nil
+	for _, key := range keys {
+		if status != "" && key.Status != status {
+			continue
+		}
+		if serviceID != "" && key.ServiceID != serviceID {
+			continue
+		}
+		if nameFilter != "" {
+			if matched, _ := stdpath.Match(nameFilter, key.Name); !matched {
+				continue
+			}
+		}
+		if nameContains != "" && !strings.Contains(key.Name, nameContains) {
+			continue
+		}
+		if !createdAfter.IsZero() || !createdBefore.IsZero() {
+			createdAt, err := time.Parse(time.RFC3339, key.CreatedAt)
+			if err != nil {
+				continue
+			}
+			if !createdAfter.IsZero() && !createdAt.After(createdAfter) {
+				continue
+			}
+			if !createdBefore.IsZero() && !createdAt.Before(createdBefore) {
+				continue
+			}
+		}
+		data.Keys = append(data.Keys, sshKeyDataSourceModel{
+			ID:          types.StringValue(key.ID),
+			Name:        types.StringValue(key.Name),
+			SSHKey:      types.StringValue(key.SSHKey),
+			Comment:     types.StringValue(key.Comment),
+			Fingerprint: types.StringValue(key.Fingerprint),
+			Status:      types.StringValue(key.Status),
+			ServiceID:   types.StringValue(key.ServiceID),
+		})
+	}
+
+	// The Indigo API doesn't document a stable ordering for either a list
+	// response or repeated single-id fetches, so without this a plan can
+	// show a spurious reorder-only diff across applies that changed nothing.
+	// Sorting by id gives a deterministic order independent of both API
+	// response order and the order ids was specified in.
+	sort.Slice(data.Keys, func(i, j int) bool {
+		return data.Keys[i].ID.ValueString() < data.Keys[j].ID.ValueString()
+	})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
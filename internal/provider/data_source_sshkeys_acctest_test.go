@@ -0,0 +1,218 @@
+package provider_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/hakadoriya/terraform-provider-webarena/internal/provider/acctest"
+)
+
+// TestAccSSHKeysDataSource_InvalidStatusRejected confirms a status value
+// that isn't ACTIVE or DEACTIVE fails the plan with a clear error instead of
+// silently matching zero keys.
+func TestAccSSHKeysDataSource_InvalidStatusRejected(t *testing.T) {
+	srv := acctest.NewFakeIndigoServer(acctest.NewFakeSSHKeyHandler())
+	defer srv.Close()
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: acctest.ProviderConfig(srv.URL) + `
+data "webarena_indigo_sshkeys" "test" {
+  status = "Active"
+}
+`,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("Invalid status Value"),
+			},
+		},
+	})
+}
+
+// TestAccSSHKeysDataSource_NameContainsFilter confirms name_contains matches
+// on substring rather than requiring a full glob pattern like name_filter.
+func TestAccSSHKeysDataSource_NameContainsFilter(t *testing.T) {
+	handler := acctest.NewFakeSSHKeyHandler()
+	srv := acctest.NewFakeIndigoServer(handler)
+	defer srv.Close()
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: acctest.ProviderConfig(srv.URL) + fmt.Sprintf(`
+resource "webarena_indigo_sshkey" "web" {
+  name   = "web-frontend"
+  sshkey = %q
+}
+
+resource "webarena_indigo_sshkey" "db" {
+  name   = "db-primary"
+  sshkey = %q
+}
+
+data "webarena_indigo_sshkeys" "test" {
+  name_contains = "web"
+  depends_on    = [webarena_indigo_sshkey.web, webarena_indigo_sshkey.db]
+}
+`, testRSAKeyA, testEd25519KeyB),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.webarena_indigo_sshkeys.test", "keys.#", "1"),
+					resource.TestCheckResourceAttr("data.webarena_indigo_sshkeys.test", "keys.0.name", "web-frontend"),
+				),
+			},
+		},
+	})
+}
+
+// TestAccSSHKeysDataSource_StatusFilter confirms status narrows the result
+// set to keys reporting that exact status.
+func TestAccSSHKeysDataSource_StatusFilter(t *testing.T) {
+	handler := acctest.NewFakeSSHKeyHandler()
+	srv := acctest.NewFakeIndigoServer(handler)
+	defer srv.Close()
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: acctest.ProviderConfig(srv.URL) + fmt.Sprintf(`
+resource "webarena_indigo_sshkey" "active" {
+  name   = "acctest-active"
+  sshkey = %q
+}
+
+resource "webarena_indigo_sshkey" "deactive" {
+  name   = "acctest-deactive"
+  sshkey = %q
+  status = "DEACTIVE"
+}
+
+data "webarena_indigo_sshkeys" "test" {
+  status     = "DEACTIVE"
+  depends_on = [webarena_indigo_sshkey.active, webarena_indigo_sshkey.deactive]
+}
+`, testRSAKeyA, testEd25519KeyB),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.webarena_indigo_sshkeys.test", "keys.#", "1"),
+					resource.TestCheckResourceAttr("data.webarena_indigo_sshkeys.test", "keys.0.name", "acctest-deactive"),
+				),
+			},
+		},
+	})
+}
+
+// TestAccSSHKeysDataSource_ServiceIDFilter confirms service_id narrows the
+// result set to keys scoped to that exact VM/service.
+func TestAccSSHKeysDataSource_ServiceIDFilter(t *testing.T) {
+	handler := acctest.NewFakeSSHKeyHandler()
+	srv := acctest.NewFakeIndigoServer(handler)
+	defer srv.Close()
+
+	scopedID := handler.SeedWithServiceID("acctest-scoped", testRSAKeyA, "vm-123")
+	unscopedID := handler.SeedWithServiceID("acctest-unscoped", testEd25519KeyB, "vm-456")
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: acctest.ProviderConfig(srv.URL) + fmt.Sprintf(`
+data "webarena_indigo_sshkeys" "test" {
+  ids        = [%q, %q]
+  service_id = "vm-123"
+}
+`, scopedID, unscopedID),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.webarena_indigo_sshkeys.test", "keys.#", "1"),
+					resource.TestCheckResourceAttr("data.webarena_indigo_sshkeys.test", "keys.0.name", "acctest-scoped"),
+				),
+			},
+		},
+	})
+}
+
+// TestAccSSHKeysDataSource_CreatedAfterFilter confirms created_after excludes
+// keys created at or before the given time. The fake server's deterministic
+// clock means the first created key's own timestamp is a precise boundary:
+// using it as created_after must exclude that key and include only ones
+// created strictly later.
+func TestAccSSHKeysDataSource_CreatedAfterFilter(t *testing.T) {
+	handler := acctest.NewFakeSSHKeyHandler()
+	srv := acctest.NewFakeIndigoServer(handler)
+	defer srv.Close()
+
+	earlyID := handler.Seed("acctest-early", testRSAKeyA)
+	lateID := handler.Seed("acctest-late", testEd25519KeyB)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: acctest.ProviderConfig(srv.URL) + fmt.Sprintf(`
+data "webarena_indigo_sshkeys" "test" {
+  ids           = [%q, %q]
+  created_after = "2023-11-14T22:13:21Z"
+}
+`, earlyID, lateID),
+				Check: resource.TestCheckResourceAttr("data.webarena_indigo_sshkeys.test", "keys.#", "1"),
+			},
+		},
+	})
+}
+
+// TestAccSSHKeysDataSource_CreatedBeforeFilter confirms created_before
+// excludes keys created at or after the given time.
+func TestAccSSHKeysDataSource_CreatedBeforeFilter(t *testing.T) {
+	handler := acctest.NewFakeSSHKeyHandler()
+	srv := acctest.NewFakeIndigoServer(handler)
+	defer srv.Close()
+
+	earlyID := handler.Seed("acctest-early", testRSAKeyA)
+	lateID := handler.Seed("acctest-late", testEd25519KeyB)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: acctest.ProviderConfig(srv.URL) + fmt.Sprintf(`
+data "webarena_indigo_sshkeys" "test" {
+  ids            = [%q, %q]
+  created_before = "2023-11-14T22:13:22Z"
+}
+`, earlyID, lateID),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.webarena_indigo_sshkeys.test", "keys.#", "1"),
+					resource.TestCheckResourceAttr("data.webarena_indigo_sshkeys.test", "keys.0.name", "acctest-early"),
+				),
+			},
+		},
+	})
+}
+
+// TestAccSSHKeysDataSource_InvalidCreatedRangeRejected confirms a
+// created_before at or before created_after fails the plan instead of
+// silently matching zero keys.
+func TestAccSSHKeysDataSource_InvalidCreatedRangeRejected(t *testing.T) {
+	srv := acctest.NewFakeIndigoServer(acctest.NewFakeSSHKeyHandler())
+	defer srv.Close()
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: acctest.ProviderConfig(srv.URL) + `
+data "webarena_indigo_sshkeys" "test" {
+  created_after  = "2023-11-14T22:13:22Z"
+  created_before = "2023-11-14T22:13:21Z"
+}
+`,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("Invalid created_after/created_before Range"),
+			},
+		},
+	})
+}
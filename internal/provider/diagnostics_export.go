@@ -0,0 +1,86 @@
+package provider
+
+import (
+	"encoding/json"
+	"os"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+
+	"github.com/hakadoriya/terraform-provider-webarena/internal/indigo"
+)
+
+// diagnosticsExportMu serializes appends to the WEBARENA_INDIGO_DIAGNOSTICS_JSON_FILE
+// file, since multiple resources/data sources can run CRUD operations
+// concurrently within the same provider instance.
+var diagnosticsExportMu sync.Mutex
+
+// exportedDiagnostic is one line written to WEBARENA_INDIGO_DIAGNOSTICS_JSON_FILE.
+type exportedDiagnostic struct {
+	Op       string `json:"op"`
+	Severity string `json:"severity"`
+	Summary  string `json:"summary"`
+	Detail   string `json:"detail"`
+	Path     string `json:"path,omitempty"`
+}
+
+// exportDiagnostics appends one JSON line per entry in diags to the file
+// named by WEBARENA_INDIGO_DIAGNOSTICS_JSON_FILE, for automated pipelines
+// that want to react to a specific failure programmatically instead of
+// scraping human-readable provider output. op identifies the CRUD step the
+// diagnostics came from (e.g. "sshkey.Create"). A no-op when the env var is
+// unset, so this costs nothing for the common case.
+//
+// client.RedactSecret strips the configured API key from every field before
+// it's written, since a diagnostic detail can echo back request/response
+// content (e.g. a gateway error page quoting the offending header).
+func exportDiagnostics(client *indigo.Client, op string, diags diag.Diagnostics) {
+	if len(diags) == 0 {
+		return
+	}
+	path := os.Getenv("WEBARENA_INDIGO_DIAGNOSTICS_JSON_FILE")
+	if path == "" {
+		return
+	}
+
+	diagnosticsExportMu.Lock()
+	defer diagnosticsExportMu.Unlock()
+
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o600)
+	if err != nil {
+		return
+	}
+	defer f.Close()
+
+	enc := json.NewEncoder(f)
+	for _, d := range diags {
+		entry := exportedDiagnostic{
+			Op:       op,
+			Severity: severityString(d.Severity()),
+			Summary:  redact(client, d.Summary()),
+			Detail:   redact(client, d.Detail()),
+		}
+		if withPath, ok := d.(diag.DiagnosticWithPath); ok {
+			entry.Path = withPath.Path().String()
+		}
+		_ = enc.Encode(entry)
+	}
+}
+
+func severityString(s diag.Severity) string {
+	switch s {
+	case diag.SeverityError:
+		return "error"
+	case diag.SeverityWarning:
+		return "warning"
+	default:
+		return "unknown"
+	}
+}
+
+func redact(client *indigo.Client, s string) string {
+	if client == nil {
+		return s
+	}
+	return client.RedactSecret(s)
+}
@@ -0,0 +1,114 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/hakadoriya/terraform-provider-webarena/internal/indigo"
+)
+
+var (
+	_ ephemeral.EphemeralResource              = &sshKeyEphemeralResource{}
+	_ ephemeral.EphemeralResourceWithConfigure = &sshKeyEphemeralResource{}
+	_ ephemeral.EphemeralResourceWithClose     = &sshKeyEphemeralResource{}
+)
+
+func NewSSHKeyEphemeralResource() ephemeral.EphemeralResource {
+	return &sshKeyEphemeralResource{}
+}
+
+// sshKeyEphemeralResource implements the webarena_indigo_sshkey ephemeral
+// resource: it registers an SSH key for the lifetime of an apply and
+// unregisters it afterwards, so the key material is never written to state.
+type sshKeyEphemeralResource struct {
+	client *indigo.Client
+}
+
+// sshKeyEphemeralResourceModel maps the ephemeral sshkey schema to a Go struct.
+type sshKeyEphemeralResourceModel struct {
+	Name        types.String `tfsdk:"name"`
+	SSHKey      types.String `tfsdk:"sshkey"`
+	ID          types.String `tfsdk:"id"`
+	Fingerprint types.String `tfsdk:"fingerprint"`
+}
+
+func (e *sshKeyEphemeralResource) Metadata(_ context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_indigo_sshkey"
+}
+
+func (e *sshKeyEphemeralResource) Schema(_ context.Context, _ ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Registers an SSH key with the Indigo API for the duration of a single apply, then tears it down. The key is never persisted to state, making it suitable for bootstrap flows that shouldn't leak key material.",
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				Required:    true,
+				Description: "Name used to identify the key in the Indigo control panel.",
+			},
+			"sshkey": schema.StringAttribute{
+				Required:    true,
+				Description: "The public key material.",
+			},
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "Identifier assigned by the Indigo API for the duration of this ephemeral resource.",
+			},
+			"fingerprint": schema.StringAttribute{
+				Computed:    true,
+				Description: "Fingerprint of the registered public key.",
+			},
+		},
+	}
+}
+
+func (e *sshKeyEphemeralResource) Configure(_ context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	config, ok := req.ProviderData.(*ProviderConfig)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected EphemeralResource Configure Type",
+			fmt.Sprintf("Expected *provider.ProviderConfig, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	e.client = config.Client
+}
+
+func (e *sshKeyEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var data sshKeyEphemeralResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	key, err := e.client.CreateSSHKey(ctx, indigo.SSHKeyParams{Name: data.Name.ValueString(), SSHKey: data.SSHKey.ValueString()})
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to Register Ephemeral SSH Key", opError("create", "ephemeral sshkey", data.Name.ValueString(), err).Error())
+		return
+	}
+
+	data.ID = types.StringValue(key.ID)
+	data.Fingerprint = types.StringValue(key.Fingerprint)
+
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Private.SetKey(ctx, "sshkey_id", []byte(key.ID))...)
+}
+
+func (e *sshKeyEphemeralResource) Close(ctx context.Context, req ephemeral.CloseRequest, resp *ephemeral.CloseResponse) {
+	id, diags := req.Private.GetKey(ctx, "sshkey_id")
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() || len(id) == 0 {
+		return
+	}
+
+	if err := e.client.DeleteSSHKey(ctx, string(id)); err != nil {
+		resp.Diagnostics.AddError("Unable to Tear Down Ephemeral SSH Key", opError("delete", "ephemeral sshkey", string(id), err).Error())
+	}
+}
@@ -0,0 +1,81 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+
+	"github.com/hakadoriya/terraform-provider-webarena/internal/indigo"
+)
+
+// OperationError is the dedicated error type returned by opError. It is
+// exported so that tooling built around this provider (acceptance test
+// helpers, wrapper CLIs importing this module) can errors.As into it to
+// inspect which CRUD operation, resource kind, and id an error occurred
+// against, rather than parsing the formatted message.
+type OperationError struct {
+	// Op is the CRUD-ish verb the failing call attempted, e.g. "create" or "read".
+	Op string
+	// Kind is the resource kind involved, e.g. "sshkey" or "ephemeral sshkey".
+	Kind string
+	// ID is the resource identifier involved, or the name when no id exists yet.
+	ID  string
+	Err error
+}
+
+func (e *OperationError) Error() string {
+	var apiErr *indigo.APIError
+	if errors.As(e.Err, &apiErr) {
+		return fmt.Sprintf("%s %s %s (HTTP %d): %s", e.Op, e.Kind, e.ID, apiErr.StatusCode, e.Err)
+	}
+	return fmt.Sprintf("%s %s %s: %s", e.Op, e.Kind, e.ID, e.Err)
+}
+
+func (e *OperationError) Unwrap() error {
+	return e.Err
+}
+
+// opError wraps err with the CRUD operation, resource kind, and id it
+// occurred against, preserving the error chain so callers can still
+// errors.Is/As through it. When err is an *indigo.APIError, its raw HTTP
+// status code is surfaced in the message too, so diagnostics don't require
+// digging through TF_LOG to tell a 404 from a 500.
+func opError(op, kind, id string, err error) error {
+	return &OperationError{Op: op, Kind: kind, ID: id, Err: err}
+}
+
+// handleGone centralizes the "the resource no longer exists upstream"
+// pattern: if err represents a 404 from the Indigo API, it removes the
+// resource from state so Terraform plans to recreate it, and reports
+// handled=true so the caller can return early without also adding an error
+// diagnostic.
+func handleGone(ctx context.Context, err error, state *tfsdk.State) (handled bool) {
+	if !indigo.IsNotFound(err) {
+		return false
+	}
+	state.RemoveResource(ctx)
+	return true
+}
+
+// errNilResponse is wrapped by requireResponse when an Indigo API call
+// reports success (a nil error) but hands back a nil payload, which should
+// never happen but would panic on the first field access if it ever did.
+var errNilResponse = errors.New("indigo API returned a successful response with no payload")
+
+// requireResponse centralizes the "an API call succeeded but returned
+// nothing" guard across CRUD methods: each one otherwise has to repeat its
+// own inline "if key == nil" check with a hand-written message. summary is
+// the diagnostic title (e.g. "Unable to Create SSH Key"); op/kind/id match
+// the opError convention so the body reads the same as any other Indigo API
+// failure. Reports ok=false when v was nil, after already appending the
+// diagnostic, so callers can just `if !ok { return }`.
+func requireResponse[T any](diags *diag.Diagnostics, v *T, summary, op, kind, id string) (_ *T, ok bool) {
+	if v == nil {
+		diags.AddError(summary, opError(op, kind, id, errNilResponse).Error())
+		return nil, false
+	}
+	return v, true
+}
@@ -0,0 +1,121 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hakadoriya/terraform-provider-webarena/internal/indigo"
+)
+
+// TestHandleGone confirms a 404 removes the resource from state and reports
+// handled=true, while any other error (or none) leaves state untouched and
+// reports handled=false.
+func TestHandleGone(t *testing.T) {
+	ctx := context.Background()
+
+	schemaResp := &resource.SchemaResponse{}
+	(&sshKeyResource{}).Schema(ctx, resource.SchemaRequest{}, schemaResp)
+	if schemaResp.Diagnostics.HasError() {
+		t.Fatalf("building schema: %v", schemaResp.Diagnostics)
+	}
+
+	newState := func() tfsdk.State {
+		var model sshKeyResourceModel
+		model.ID = types.StringValue("123")
+		model.Name = types.StringValue("test")
+		model.Comment = types.StringValue("")
+		model.Status = types.StringValue("ACTIVE")
+		model.Tags = types.MapNull(types.StringType)
+		state := tfsdk.State{
+			Schema: schemaResp.Schema,
+			Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
+		}
+		if diags := state.Set(ctx, &model); diags.HasError() {
+			t.Fatalf("seeding state: %v", diags)
+		}
+		return state
+	}
+
+	t.Run("404 removes the resource", func(t *testing.T) {
+		state := newState()
+		err := &indigo.APIError{StatusCode: 404}
+
+		if handled := handleGone(ctx, err, &state); !handled {
+			t.Fatal("handleGone returned false for a 404, want true")
+		}
+		if !state.Raw.IsNull() {
+			t.Error("state was not removed after a 404")
+		}
+	})
+
+	t.Run("other API errors are left alone", func(t *testing.T) {
+		state := newState()
+		err := &indigo.APIError{StatusCode: 500}
+
+		if handled := handleGone(ctx, err, &state); handled {
+			t.Fatal("handleGone returned true for a 500, want false")
+		}
+		if state.Raw.IsNull() {
+			t.Error("state was removed for a non-404 error")
+		}
+	})
+
+	t.Run("non-API errors are left alone", func(t *testing.T) {
+		state := newState()
+		err := errors.New("boom")
+
+		if handled := handleGone(ctx, err, &state); handled {
+			t.Fatal("handleGone returned true for a non-API error, want false")
+		}
+		if state.Raw.IsNull() {
+			t.Error("state was removed for a non-API error")
+		}
+	})
+}
+
+// TestRequireResponse confirms requireResponse passes a non-nil value
+// through untouched, and turns a nil value into an error diagnostic plus
+// ok=false instead of letting callers dereference it.
+func TestRequireResponse(t *testing.T) {
+	t.Run("non-nil value passes through", func(t *testing.T) {
+		var diags diag.Diagnostics
+		key := &indigo.SSHKey{ID: "123"}
+
+		got, ok := requireResponse(&diags, key, "Unable to Create SSH Key", "create", "sshkey", "test")
+		if !ok {
+			t.Fatal("requireResponse returned ok=false for a non-nil value")
+		}
+		if diags.HasError() {
+			t.Fatalf("requireResponse appended unexpected diagnostics: %v", diags)
+		}
+		if got != key {
+			t.Errorf("requireResponse returned %v, want the same pointer %v", got, key)
+		}
+	})
+
+	t.Run("nil value reports an error", func(t *testing.T) {
+		var diags diag.Diagnostics
+		var key *indigo.SSHKey
+
+		got, ok := requireResponse(&diags, key, "Unable to Create SSH Key", "create", "sshkey", "test")
+		if ok {
+			t.Fatal("requireResponse returned ok=true for a nil value")
+		}
+		if got != nil {
+			t.Errorf("requireResponse returned %v, want nil", got)
+		}
+		if !diags.HasError() {
+			t.Fatal("requireResponse did not append an error diagnostic for a nil value")
+		}
+		if summary := diags[0].Summary(); summary != "Unable to Create SSH Key" {
+			t.Errorf("diagnostic summary = %q, want %q", summary, "Unable to Create SSH Key")
+		}
+	})
+}
@@ -0,0 +1,349 @@
+// Package provider implements the terraform-provider-webarena Terraform
+// provider on top of the terraform-plugin-framework.
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/hakadoriya/terraform-provider-webarena/internal/indigo"
+)
+
+// Ensure WebarenaProvider satisfies the expected interfaces.
+var (
+	_ provider.Provider                       = &WebarenaProvider{}
+	_ provider.ProviderWithEphemeralResources = &WebarenaProvider{}
+	_ provider.ProviderWithConfigValidators   = &WebarenaProvider{}
+)
+
+// WebarenaProvider is the provider.Provider implementation for webarena.
+type WebarenaProvider struct {
+	// version is set to the provider version at release build time, or
+	// "dev" when the provider is built locally for testing.
+	version string
+}
+
+// webarenaProviderModel maps the provider schema to a Go struct.
+type webarenaProviderModel struct {
+	Endpoint                      types.String `tfsdk:"endpoint"`
+	APIKey                        types.String `tfsdk:"api_key"`
+	ValidateOnConfigure           types.Bool   `tfsdk:"validate_on_configure"`
+	Region                        types.String `tfsdk:"region"`
+	SSHKeyJSONField               types.String `tfsdk:"sshkey_json_field"`
+	PathPrefix                    types.String `tfsdk:"path_prefix"`
+	MaxConcurrentRequests         types.Int64  `tfsdk:"max_concurrent_requests"`
+	MaxConcurrentDestroys         types.Int64  `tfsdk:"max_concurrent_destroys"`
+	APIVersion                    types.String `tfsdk:"api_version"`
+	ListCacheSeconds              types.Int64  `tfsdk:"list_cache_seconds"`
+	LogLevel                      types.String `tfsdk:"log_level"`
+	DefaultTimeoutSeconds         types.Int64  `tfsdk:"default_timeout_seconds"`
+	DefaultTags                   types.Map    `tfsdk:"default_tags"`
+	Offline                       types.Bool   `tfsdk:"offline"`
+	CircuitBreakerThreshold       types.Int64  `tfsdk:"circuit_breaker_threshold"`
+	CircuitBreakerCooldownSeconds types.Int64  `tfsdk:"circuit_breaker_cooldown_seconds"`
+	UserAgentSuffix               types.String `tfsdk:"user_agent_suffix"`
+	DefaultStatus                 types.String `tfsdk:"default_status"`
+	ReadOnly                      types.Bool   `tfsdk:"read_only"`
+	RetryNetworkMaxAttempts       types.Int64  `tfsdk:"retry_network_max_attempts"`
+	RetryServerErrorMaxAttempts   types.Int64  `tfsdk:"retry_server_error_max_attempts"`
+	RetryRateLimitMaxAttempts     types.Int64  `tfsdk:"retry_rate_limit_max_attempts"`
+	RequestHeaders                types.Map    `tfsdk:"request_headers"`
+	MaxResponseBytes              types.Int64  `tfsdk:"max_response_bytes"`
+}
+
+func New(version string) func() provider.Provider {
+	return func() provider.Provider {
+		return &WebarenaProvider{version: version}
+	}
+}
+
+func (p *WebarenaProvider) Metadata(_ context.Context, _ provider.MetadataRequest, resp *provider.MetadataResponse) {
+	resp.TypeName = "webarena"
+	resp.Version = p.version
+}
+
+func (p *WebarenaProvider) Schema(_ context.Context, _ provider.SchemaRequest, resp *provider.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Interact with the WebARena Indigo VPS API. In addition to the attributes below, endpoint/api_key/region/sshkey_json_field can be supplied in bulk as a JSON object via the WEBARENA_INDIGO_CONFIG_JSON environment variable; individual WEBARENA_INDIGO_* environment variables take precedence over it. Setting WEBARENA_INDIGO_DIAGNOSTICS_JSON_FILE additionally appends every diagnostic the webarena_indigo_sshkey resource emits (severity, summary, detail, attribute path, secrets redacted) as a JSON line to that file, for automated pipelines that want to react to a specific failure programmatically.",
+		Attributes: map[string]schema.Attribute{
+			"endpoint": schema.StringAttribute{
+				Optional:    true,
+				Description: "Base URL of the Indigo API. Defaults to the public WebARena Indigo endpoint, overridable via the WEBARENA_INDIGO_ENDPOINT environment variable.",
+			},
+			"api_key": schema.StringAttribute{
+				Optional:    true,
+				Sensitive:   true,
+				Description: "API key used to authenticate against the Indigo API. Can also be set via the WEBARENA_INDIGO_API_KEY environment variable, or WEBARENA_INDIGO_API_KEY_FILE to read it from a file instead (the Docker/Kubernetes secrets convention); WEBARENA_INDIGO_API_KEY_FILE takes precedence over WEBARENA_INDIGO_API_KEY.",
+			},
+			"validate_on_configure": schema.BoolAttribute{
+				Optional:    true,
+				Description: "When true, Configure performs a lightweight authenticated request against the Indigo API and fails provider configuration immediately if it does not succeed, instead of surfacing the error on the first resource operation. Defaults to false.",
+			},
+			"region": schema.StringAttribute{
+				Optional:    true,
+				Description: "Default region used by region-aware resources and data sources when their own region attribute is unset. Can also be set via the WEBARENA_INDIGO_REGION environment variable.",
+			},
+			"sshkey_json_field": schema.StringAttribute{
+				Optional:    true,
+				Description: "Overrides the JSON field name used for the SSH key body in API requests. Defaults to \"sshkey\"; set this when fronting the Indigo API with a gateway that renames it (e.g. to \"public_key\"). Responses are read from whichever of sshkey, public_key, or key is present; a response field under any other name is not read.",
+			},
+			"path_prefix": schema.StringAttribute{
+				Optional:    true,
+				Description: "Prepended to every request path after endpoint, for API gateway deployments that mount the Indigo API under an additional path segment (e.g. \"/my-gateway\"). Can also be set via the WEBARENA_INDIGO_PATH_PREFIX environment variable.",
+			},
+			"max_concurrent_requests": schema.Int64Attribute{
+				Optional:    true,
+				Description: "Caps the number of Indigo API requests this provider instance will have in-flight at once, to avoid overloading the API during a large destroy/apply. Unset or 0 means unlimited.",
+			},
+			"max_concurrent_destroys": schema.Int64Attribute{
+				Optional:    true,
+				Description: "Additionally caps the number of destroy (DELETE) requests this provider instance will have in-flight at once, on top of max_concurrent_requests. Destroys are the riskiest operation to run at high concurrency, so a large `terraform destroy` can be throttled harder than general throughput. Unset or 0 means no destroy-specific bound beyond max_concurrent_requests.",
+			},
+			"api_version": schema.StringAttribute{
+				Optional:    true,
+				Description: "When set, Configure asserts that the Indigo API reports this exact version and fails fast if it doesn't, catching incompatible API upgrades before any resource work begins.",
+			},
+			"list_cache_seconds": schema.Int64Attribute{
+				Optional:    true,
+				Description: "Caches the account's SSH key list for this many seconds, so a single plan/apply that reads it from multiple read-heavy data sources or resources issues one request instead of one per caller. Any create/update/reactivate/delete invalidates the cache immediately. Unset or 0 disables caching.",
+			},
+			"log_level": schema.StringAttribute{
+				Optional:    true,
+				Description: "Overrides the log level (TRACE, DEBUG, INFO, WARN, ERROR) used by this provider instance's own diagnostic logging, independent of the ambient TF_LOG. Unset defers entirely to TF_LOG.",
+			},
+			"default_timeout_seconds": schema.Int64Attribute{
+				Optional:    true,
+				Description: "Per-request timeout, in seconds, applied to every individual Indigo API request made by this provider instance -- independent of, and typically much shorter than, any overall operation timeout Terraform itself enforces around a resource's CRUD step. This is what makes a single hung HTTP call fail fast instead of consuming the whole operation budget before a retry or the next request gets a chance to run. Unset or 0 means no additional bound beyond Terraform's own operation timeout.",
+			},
+			"default_tags": schema.MapAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "Tags merged into every tag-supporting resource's own tags attribute, with the resource's own tags taking precedence on key conflicts.",
+			},
+			"offline": schema.BoolAttribute{
+				Optional:    true,
+				Description: "When true, resources skip the Read API call and trust the attributes already in state, for plans run against an unreachable or deliberately firewalled Indigo API. Has no effect on create/update/delete. Defaults to false.",
+			},
+			"circuit_breaker_threshold": schema.Int64Attribute{
+				Optional:    true,
+				Description: "After this many consecutive Indigo API request failures (transport errors or 5xx responses), the provider fails every subsequent request immediately instead of waiting out its own timeout, until circuit_breaker_cooldown_seconds has elapsed. Unset or 0 disables the breaker.",
+			},
+			"circuit_breaker_cooldown_seconds": schema.Int64Attribute{
+				Optional:    true,
+				Description: "How long the circuit breaker stays open before letting the next request through as a probe. Only meaningful when circuit_breaker_threshold is set. Defaults to 30 seconds.",
+			},
+			"user_agent_suffix": schema.StringAttribute{
+				Optional:    true,
+				Description: "Appended to the User-Agent header sent with every Indigo API request, so a request log can attribute calls to the higher-level tool driving this provider (e.g. a wrapper CLI or CI pipeline).",
+			},
+			"default_status": schema.StringAttribute{
+				Optional:    true,
+				Description: "Overrides the default status (ACTIVE or DEACTIVE) newly created sshkey resources use when their own config omits status, for environments (e.g. a staging provider block) that want a different default than production without repeating it on every resource.",
+			},
+			"read_only": schema.BoolAttribute{
+				Optional:    true,
+				Description: "When true, every non-read Indigo API request (create, update, delete, reactivate) is rejected before it reaches the network, for a provider instance wired up purely to read existing state. Defaults to false.",
+			},
+			"retry_network_max_attempts": schema.Int64Attribute{
+				Optional:    true,
+				Description: "Number of times to retry, with exponential backoff and jitter, a request that fails with a transport-level error (DNS, connection refused, timeout, ...). Unset or 0 disables retrying this error class.",
+			},
+			"retry_server_error_max_attempts": schema.Int64Attribute{
+				Optional:    true,
+				Description: "Number of times to retry, with exponential backoff and jitter, a request that receives a 5xx response. Unset or 0 disables retrying this error class.",
+			},
+			"retry_rate_limit_max_attempts": schema.Int64Attribute{
+				Optional:    true,
+				Description: "Number of times to retry, with exponential backoff and jitter, a request that receives a 429 response. Unset or 0 disables retrying this error class.",
+			},
+			"request_headers": schema.MapAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "Static headers attached to every Indigo API request, for gateway deployments that require e.g. a tenant header or gateway key in addition to the Indigo API's own authentication. Authorization, Content-Type, Accept, and User-Agent are always set by the client itself and entries here under those names are ignored.",
+			},
+			"max_response_bytes": schema.Int64Attribute{
+				Optional:    true,
+				Description: "Caps how many bytes of a response body the client will read before failing, to guard against a misbehaving or untrusted gateway returning an enormous body (e.g. a giant error page) and exhausting memory. Unset or 0 means no limit.",
+			},
+		},
+	}
+}
+
+func (p *WebarenaProvider) Configure(ctx context.Context, req provider.ConfigureRequest, resp *provider.ConfigureResponse) {
+	var data webarenaProviderModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	jsonCfg, err := loadJSONProviderConfig()
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid WEBARENA_INDIGO_CONFIG_JSON", err.Error())
+		return
+	}
+
+	endpoint := envOr("WEBARENA_INDIGO_ENDPOINT", firstNonEmpty(data.Endpoint.ValueString(), jsonCfg.Endpoint))
+	apiKey, err := envFileOr("WEBARENA_INDIGO_API_KEY", firstNonEmpty(data.APIKey.ValueString(), jsonCfg.APIKey))
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to Read API Key File", err.Error())
+		return
+	}
+
+	if apiKey == "" {
+		resp.Diagnostics.AddError(
+			"Missing API Key",
+			"The provider requires an api_key, set via provider configuration, the WEBARENA_INDIGO_API_KEY environment variable, or the WEBARENA_INDIGO_CONFIG_JSON environment variable.",
+		)
+		return
+	}
+
+	if endpoint == "" {
+		endpoint = indigo.RegionalEndpoint(envOr("WEBARENA_INDIGO_REGION", firstNonEmpty(data.Region.ValueString(), jsonCfg.Region)))
+	}
+
+	if err := validateEndpoint(endpoint); err != nil {
+		resp.Diagnostics.AddError("Invalid Endpoint", err.Error())
+		return
+	}
+
+	// Trim trailing slashes so Endpoint+PathPrefix+path never produces a
+	// doubled "//" in the request URL -- some gateways 404 on that instead
+	// of collapsing it the way a browser address bar would.
+	endpoint = strings.TrimRight(endpoint, "/")
+
+	if u, parseErr := url.Parse(endpoint); parseErr == nil && !strings.HasSuffix(u.Hostname(), "web.arena.ne.jp") {
+		resp.Diagnostics.AddWarning(
+			"Unexpected Endpoint Host",
+			fmt.Sprintf("endpoint %q does not look like a WebARena Indigo host (expected it to end in web.arena.ne.jp). "+
+				"This is fine for a gateway/proxy deployment, but double-check for a typo if that wasn't intentional.", endpoint),
+		)
+	}
+
+	region := envOr("WEBARENA_INDIGO_REGION", firstNonEmpty(data.Region.ValueString(), jsonCfg.Region))
+	client := indigo.NewClient(endpoint, apiKey, region)
+	client.SSHKeyJSONField = firstNonEmpty(data.SSHKeyJSONField.ValueString(), jsonCfg.SSHKeyJSONField)
+	client.PathPrefix = envOr("WEBARENA_INDIGO_PATH_PREFIX", data.PathPrefix.ValueString())
+	client.SetMaxConcurrentRequests(int(data.MaxConcurrentRequests.ValueInt64()))
+	client.SetMaxConcurrentDestroys(int(data.MaxConcurrentDestroys.ValueInt64()))
+	client.ListSSHKeysCacheTTL = time.Duration(data.ListCacheSeconds.ValueInt64()) * time.Second
+	client.LogLevel = strings.ToUpper(strings.TrimSpace(data.LogLevel.ValueString()))
+	client.RequestTimeout = time.Duration(data.DefaultTimeoutSeconds.ValueInt64()) * time.Second
+	client.Offline = data.Offline.ValueBool()
+	client.ReadOnly = data.ReadOnly.ValueBool()
+
+	retryPolicies := map[string]indigo.RetryPolicy{}
+	if n := data.RetryNetworkMaxAttempts.ValueInt64(); n > 0 {
+		retryPolicies[indigo.RetryClassNetwork] = indigo.RetryPolicy{MaxAttempts: int(n)}
+	}
+	if n := data.RetryServerErrorMaxAttempts.ValueInt64(); n > 0 {
+		retryPolicies[indigo.RetryClassServer] = indigo.RetryPolicy{MaxAttempts: int(n)}
+	}
+	if n := data.RetryRateLimitMaxAttempts.ValueInt64(); n > 0 {
+		retryPolicies[indigo.RetryClassRateLimit] = indigo.RetryPolicy{MaxAttempts: int(n)}
+	}
+	client.RetryPolicies = retryPolicies
+	client.CircuitBreakerThreshold = int(data.CircuitBreakerThreshold.ValueInt64())
+	client.CircuitBreakerCooldown = time.Duration(data.CircuitBreakerCooldownSeconds.ValueInt64()) * time.Second
+	client.UserAgentSuffix = data.UserAgentSuffix.ValueString()
+
+	if !data.RequestHeaders.IsNull() {
+		var requestHeaders map[string]string
+		resp.Diagnostics.Append(data.RequestHeaders.ElementsAs(ctx, &requestHeaders, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		client.RequestHeaders = requestHeaders
+	}
+
+	client.MaxResponseBytes = data.MaxResponseBytes.ValueInt64()
+
+	if pinned := data.APIVersion.ValueString(); pinned != "" {
+		got, err := client.GetAPIVersion(ctx)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Unable to Determine Indigo API Version",
+				"api_version is set but the Indigo API version could not be determined: "+err.Error(),
+			)
+			return
+		}
+		if got != pinned {
+			resp.Diagnostics.AddError(
+				"Indigo API Version Mismatch",
+				fmt.Sprintf("Configured api_version %q does not match the Indigo API's reported version %q.", pinned, got),
+			)
+			return
+		}
+	}
+
+	if data.ValidateOnConfigure.ValueBool() {
+		if _, err := client.ListSSHKeys(ctx); err != nil {
+			resp.Diagnostics.AddError(
+				"Unable to Validate Indigo API Connectivity",
+				"validate_on_configure is enabled but a precheck request against the Indigo API failed. "+
+					"Check that endpoint and api_key are correct: "+err.Error(),
+			)
+			return
+		}
+	}
+
+	var defaultTags map[string]string
+	if !data.DefaultTags.IsNull() {
+		resp.Diagnostics.Append(data.DefaultTags.ElementsAs(ctx, &defaultTags, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	config := &ProviderConfig{
+		Client:        client,
+		DefaultRegion: region,
+		DefaultTags:   defaultTags,
+		DefaultStatus: data.DefaultStatus.ValueString(),
+	}
+
+	resp.ResourceData = config
+	resp.DataSourceData = config
+}
+
+// ConfigValidators rejects provider configuration up front when no
+// credential source supplies api_key, instead of deferring that check to
+// Configure where it runs after every attribute default has already been
+// resolved.
+func (p *WebarenaProvider) ConfigValidators(_ context.Context) []provider.ConfigValidator {
+	return []provider.ConfigValidator{&atLeastOneAuthMethodValidator{}, &nonNegativeDurationsValidator{}}
+}
+
+func (p *WebarenaProvider) Resources(_ context.Context) []func() resource.Resource {
+	return []func() resource.Resource{
+		NewSSHKeyResource,
+		NewSSHKeySetResource,
+		NewSSHKeyGroupResource,
+	}
+}
+
+func (p *WebarenaProvider) DataSources(_ context.Context) []func() datasource.DataSource {
+	return []func() datasource.DataSource{
+		NewSSHKeyDataSource,
+		NewSSHKeysDataSource,
+		NewPlanDataSource,
+		NewSSHKeyExportDataSource,
+		NewSSHKeyValidateDataSource,
+		NewAccountDataSource,
+	}
+}
+
+func (p *WebarenaProvider) EphemeralResources(_ context.Context) []func() ephemeral.EphemeralResource {
+	return []func() ephemeral.EphemeralResource{
+		NewSSHKeyEphemeralResource,
+	}
+}
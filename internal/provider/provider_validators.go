@@ -0,0 +1,101 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ provider.ConfigValidator = &atLeastOneAuthMethodValidator{}
+
+// atLeastOneAuthMethodValidator requires that at least one credential
+// source supplies api_key, formalizing as a declarative ConfigValidator what
+// Configure otherwise has to reject late via AddError.
+type atLeastOneAuthMethodValidator struct{}
+
+func (v *atLeastOneAuthMethodValidator) Description(_ context.Context) string {
+	return "Requires that api_key be supplied via provider configuration, WEBARENA_INDIGO_API_KEY, WEBARENA_INDIGO_API_KEY_FILE, or WEBARENA_INDIGO_CONFIG_JSON."
+}
+
+func (v *atLeastOneAuthMethodValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v *atLeastOneAuthMethodValidator) ValidateProvider(ctx context.Context, req provider.ValidateConfigRequest, resp *provider.ValidateConfigResponse) {
+	var data webarenaProviderModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	jsonCfg, err := loadJSONProviderConfig()
+	if err != nil {
+		// Configure surfaces the malformed WEBARENA_INDIGO_CONFIG_JSON error;
+		// nothing more useful to say about auth methods here.
+		return
+	}
+
+	if firstNonEmpty(data.APIKey.ValueString(), os.Getenv("WEBARENA_INDIGO_API_KEY"), os.Getenv("WEBARENA_INDIGO_API_KEY_FILE"), jsonCfg.APIKey) == "" {
+		resp.Diagnostics.AddError(
+			"No Credential Source Configured",
+			"At least one of api_key, WEBARENA_INDIGO_API_KEY, WEBARENA_INDIGO_API_KEY_FILE, or WEBARENA_INDIGO_CONFIG_JSON must supply an API key.",
+		)
+	}
+}
+
+var _ provider.ConfigValidator = &nonNegativeDurationsValidator{}
+
+// nonNegativeDurationsValidator rejects negative values for every provider
+// attribute that's handed straight to time.Duration(n)*time.Second or used as
+// a count/threshold, so a typo like -1 fails at `terraform validate` with a
+// message naming the attribute, instead of silently producing a zero/negative
+// time.Duration (read by Go as "no timeout"/"already elapsed", whichever the
+// call site happens to do with it) or a negative retry count.
+type nonNegativeDurationsValidator struct{}
+
+func (v *nonNegativeDurationsValidator) Description(_ context.Context) string {
+	return "Requires that every duration-in-seconds and count/threshold attribute be zero or positive."
+}
+
+func (v *nonNegativeDurationsValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v *nonNegativeDurationsValidator) ValidateProvider(ctx context.Context, req provider.ValidateConfigRequest, resp *provider.ValidateConfigResponse) {
+	var data webarenaProviderModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	for _, attr := range []struct {
+		name string
+		v    types.Int64
+	}{
+		{"max_concurrent_requests", data.MaxConcurrentRequests},
+		{"max_concurrent_destroys", data.MaxConcurrentDestroys},
+		{"list_cache_seconds", data.ListCacheSeconds},
+		{"default_timeout_seconds", data.DefaultTimeoutSeconds},
+		{"circuit_breaker_threshold", data.CircuitBreakerThreshold},
+		{"circuit_breaker_cooldown_seconds", data.CircuitBreakerCooldownSeconds},
+		{"retry_network_max_attempts", data.RetryNetworkMaxAttempts},
+		{"retry_server_error_max_attempts", data.RetryServerErrorMaxAttempts},
+		{"retry_rate_limit_max_attempts", data.RetryRateLimitMaxAttempts},
+		{"max_response_bytes", data.MaxResponseBytes},
+	} {
+		if attr.v.IsNull() || attr.v.IsUnknown() {
+			continue
+		}
+		if attr.v.ValueInt64() < 0 {
+			resp.Diagnostics.AddAttributeError(
+				path.Root(attr.name),
+				"Invalid Negative Value",
+				fmt.Sprintf("%s must be zero or positive, got %d.", attr.name, attr.v.ValueInt64()),
+			)
+		}
+	}
+}
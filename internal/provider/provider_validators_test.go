@@ -0,0 +1,98 @@
+package provider
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// newValidateConfigTestRequest builds a provider.ValidateConfigRequest whose
+// Config has every attribute null except the ones named in overrides, which
+// are set to the given int64 value. Config has no Set method like tfsdk.State
+// does, so the underlying tftypes.Value has to be built by hand against the
+// provider's own schema type.
+func newValidateConfigTestRequest(ctx context.Context, t *testing.T, overrides map[string]int64) provider.ValidateConfigRequest {
+	t.Helper()
+
+	schemaResp := &provider.SchemaResponse{}
+	(&WebarenaProvider{}).Schema(ctx, provider.SchemaRequest{}, schemaResp)
+	if schemaResp.Diagnostics.HasError() {
+		t.Fatalf("building schema: %v", schemaResp.Diagnostics)
+	}
+
+	objType, ok := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+	if !ok {
+		t.Fatalf("provider schema type is not an object: %T", schemaResp.Schema.Type().TerraformType(ctx))
+	}
+
+	values := make(map[string]tftypes.Value, len(objType.AttributeTypes))
+	for name, attrType := range objType.AttributeTypes {
+		if v, ok := overrides[name]; ok {
+			values[name] = tftypes.NewValue(attrType, big.NewFloat(float64(v)))
+			continue
+		}
+		values[name] = tftypes.NewValue(attrType, nil)
+	}
+
+	return provider.ValidateConfigRequest{
+		Config: tfsdk.Config{
+			Schema: schemaResp.Schema,
+			Raw:    tftypes.NewValue(objType, values),
+		},
+	}
+}
+
+func TestNonNegativeDurationsValidator_RejectsNegativeValue(t *testing.T) {
+	ctx := context.Background()
+	req := newValidateConfigTestRequest(ctx, t, map[string]int64{"max_concurrent_requests": -1})
+	resp := &provider.ValidateConfigResponse{}
+
+	(&nonNegativeDurationsValidator{}).ValidateProvider(ctx, req, resp)
+
+	if !resp.Diagnostics.HasError() {
+		t.Fatal("ValidateProvider returned no diagnostics, want an error for a negative max_concurrent_requests")
+	}
+	found := false
+	for _, d := range resp.Diagnostics {
+		if d.Summary() == "Invalid Negative Value" {
+			found = true
+		}
+	}
+	if !found {
+		t.Errorf("diagnostics = %v, want one with summary %q", resp.Diagnostics, "Invalid Negative Value")
+	}
+}
+
+func TestNonNegativeDurationsValidator_ReportsEveryNegativeAttribute(t *testing.T) {
+	ctx := context.Background()
+	req := newValidateConfigTestRequest(ctx, t, map[string]int64{
+		"circuit_breaker_threshold": -5,
+		"max_response_bytes":        -1,
+	})
+	resp := &provider.ValidateConfigResponse{}
+
+	(&nonNegativeDurationsValidator{}).ValidateProvider(ctx, req, resp)
+
+	if len(resp.Diagnostics) != 2 {
+		t.Fatalf("len(diagnostics) = %d, want 2 (one per negative attribute), got %v", len(resp.Diagnostics), resp.Diagnostics)
+	}
+}
+
+func TestNonNegativeDurationsValidator_AllowsZeroAndPositive(t *testing.T) {
+	ctx := context.Background()
+	req := newValidateConfigTestRequest(ctx, t, map[string]int64{
+		"max_concurrent_requests":    0,
+		"retry_network_max_attempts": 3,
+	})
+	resp := &provider.ValidateConfigResponse{}
+
+	(&nonNegativeDurationsValidator{}).ValidateProvider(ctx, req, resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("ValidateProvider returned unexpected diagnostics: %v", resp.Diagnostics)
+	}
+}
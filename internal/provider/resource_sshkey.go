@@ -0,0 +1,637 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/hakadoriya/terraform-provider-webarena/internal/indigo"
+)
+
+var (
+	_ resource.Resource                     = &sshKeyResource{}
+	_ resource.ResourceWithConfigure        = &sshKeyResource{}
+	_ resource.ResourceWithValidateConfig   = &sshKeyResource{}
+	_ resource.ResourceWithConfigValidators = &sshKeyResource{}
+	_ resource.ResourceWithIdentity         = &sshKeyResource{}
+)
+
+const (
+	sshKeyDefaultComment = ""
+	sshKeyDefaultStatus  = "ACTIVE"
+)
+
+// sshKeyResourceIdentityModel maps the sshkey resource identity schema to a
+// Go struct. Resource identity is independent of both the resource type name
+// and the practitioner-visible state, so it keeps working as the stable
+// handle a `moved` block needs across resource renames.
+type sshKeyResourceIdentityModel struct {
+	ID types.String `tfsdk:"id"`
+}
+
+func NewSSHKeyResource() resource.Resource {
+	return &sshKeyResource{}
+}
+
+// sshKeyResource implements the webarena_indigo_sshkey resource.
+type sshKeyResource struct {
+	client        *indigo.Client
+	defaultTags   map[string]string
+	defaultStatus string
+}
+
+// sshKeyResourceModel maps the sshkey resource schema to a Go struct.
+type sshKeyResourceModel struct {
+	ID                       types.String `tfsdk:"id"`
+	Name                     types.String `tfsdk:"name"`
+	SSHKey                   types.String `tfsdk:"sshkey"`
+	SSHKeyWO                 types.String `tfsdk:"sshkey_wo"`
+	SSHKeyWOVersion          types.String `tfsdk:"sshkey_wo_version"`
+	SSHKeyOriginal           types.String `tfsdk:"sshkey_original"`
+	Comment                  types.String `tfsdk:"comment"`
+	Tags                     types.Map    `tfsdk:"tags"`
+	Fingerprint              types.String `tfsdk:"fingerprint"`
+	Status                   types.String `tfsdk:"status"`
+	Upsert                   types.Bool   `tfsdk:"upsert"`
+	CreatedAt                types.String `tfsdk:"created_at"`
+	UpdatedAt                types.String `tfsdk:"updated_at"`
+	TreatDeactiveAsDeleted   types.Bool   `tfsdk:"treat_deactive_as_deleted"`
+	RequireEd25519           types.Bool   `tfsdk:"require_ed25519"`
+	RecreateIfDeactiveOnRead types.Bool   `tfsdk:"recreate_if_deactive_on_read"`
+	ExpiresAt                types.String `tfsdk:"expires_at"`
+	ProtectLastActive        types.Bool   `tfsdk:"protect_last_active"`
+	MinRSABits               types.Int64  `tfsdk:"min_rsa_bits"`
+	ReportDrift              types.Bool   `tfsdk:"report_drift"`
+	DeactivateOnDestroy      types.Bool   `tfsdk:"deactivate_on_destroy"`
+	WarnOnKeyTruncation      types.Bool   `tfsdk:"warn_on_key_truncation"`
+	FailOnExistingName       types.Bool   `tfsdk:"fail_on_existing_name"`
+}
+
+// keyBody returns the key material to send to the API, preferring the
+// write-only attribute when set so that plain old `sshkey` keeps working
+// for configurations that don't need write-only semantics.
+func (m sshKeyResourceModel) keyBody() string {
+	if v := m.SSHKeyWO.ValueString(); v != "" {
+		return v
+	}
+	return m.SSHKey.ValueString()
+}
+
+// sshKeyBodiesMatch reports whether newBody is the same key as existing,
+// compared by fingerprint so re-wrapping/whitespace differences the Indigo
+// API itself tolerates don't register as a mismatch. Falls back to a trimmed
+// string comparison when either side's fingerprint can't be computed (e.g. a
+// key type indigo.Fingerprint doesn't recognize), so an unparseable key
+// still gets *some* real comparison instead of silently matching everything.
+func sshKeyBodiesMatch(existing *indigo.SSHKey, newBody string) bool {
+	newFingerprint, err := indigo.Fingerprint(newBody)
+	if err == nil && existing.Fingerprint != "" {
+		return newFingerprint == existing.Fingerprint
+	}
+	return strings.TrimSpace(existing.SSHKey) == strings.TrimSpace(newBody)
+}
+
+// tagsMap converts the tags attribute to a plain map for the indigo client.
+func (m sshKeyResourceModel) tagsMap(ctx context.Context) (map[string]string, diag.Diagnostics) {
+	if m.Tags.IsNull() || m.Tags.IsUnknown() {
+		return nil, nil
+	}
+	var tags map[string]string
+	diags := m.Tags.ElementsAs(ctx, &tags, false)
+	return tags, diags
+}
+
+func (r *sshKeyResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_indigo_sshkey"
+}
+
+func (r *sshKeyResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Version:     1,
+		Description: "Registers an SSH public key with the WebARena Indigo API for use when provisioning VPS instances.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Description: "Identifier assigned by the Indigo API.",
+			},
+			"name": schema.StringAttribute{
+				Required:    true,
+				Description: "Name used to identify the key in the Indigo control panel.",
+			},
+			"sshkey": schema.StringAttribute{
+				Optional:    true,
+				Description: "The public key material, e.g. the contents of an id_ed25519.pub file. Persisted to state; use sshkey_wo instead to keep the key material out of state.",
+			},
+			"sshkey_wo": schema.StringAttribute{
+				Optional:    true,
+				WriteOnly:   true,
+				Description: "Write-only variant of sshkey: used for create/update but never persisted to state. Drift is instead detected via fingerprint. Bump sshkey_wo_version to force Terraform to apply a new value.",
+			},
+			"sshkey_wo_version": schema.StringAttribute{
+				Optional:    true,
+				Description: "Arbitrary value that, when changed, signals that sshkey_wo has changed and should be re-applied. Required when using sshkey_wo, since write-only values are not available to detect drift.",
+			},
+			"sshkey_original": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Description: "The exact key material last submitted to the Indigo API, captured before any normalization the API applies when echoing sshkey back (e.g. re-wrapping, stripping a trailing comment). Read never overwrites this from the API response, so it stays faithful to what Terraform actually applied.",
+			},
+			"comment": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  stringdefault.StaticString(sshKeyDefaultComment),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Description: descf(
+					"Comment to associate with the key, kept separate from the key body so it can be changed without affecting the fingerprint. Defaults to %q; Computed because Read reflects back whatever the API reports, so re-applying an unchanged config is a no-op.",
+					sshKeyDefaultComment,
+				),
+			},
+			"tags": schema.MapAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "Arbitrary key/value labels to associate with the key in the Indigo control panel.",
+			},
+			"fingerprint": schema.StringAttribute{
+				Computed: true,
+				// No UseStateForUnknown here: ModifyPlan already pins
+				// fingerprint to its prior value when the key body isn't
+				// changing (see resource_sshkey_modifyplan.go) and
+				// deliberately leaves it Unknown when it is, so a -refresh=false
+				// plan's fingerprint still shows "known after apply" for a
+				// genuine key rotation instead of silently carrying forward a
+				// now-stale value.
+				Description: "Fingerprint of the registered public key, as reported by the Indigo API.",
+			},
+			"status": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  stringdefault.StaticString(sshKeyDefaultStatus),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Description: descf(
+					"Desired status of the key (ACTIVE or DEACTIVE). Defaults to %s; the API's reported status is always reflected back after apply.",
+					sshKeyDefaultStatus,
+				),
+			},
+			"upsert": schema.BoolAttribute{
+				Optional:    true,
+				Description: "When true, Create first checks for an existing key with the same name and adopts it via update instead of failing on a name conflict. Defaults to false.",
+			},
+			"created_at": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Description: "Timestamp the key was created, in UTC RFC3339, regardless of which regional Indigo endpoint reported it.",
+			},
+			"updated_at": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Description: "Timestamp the key was last updated, in UTC RFC3339, regardless of which regional Indigo endpoint reported it.",
+			},
+			"treat_deactive_as_deleted": schema.BoolAttribute{
+				Optional:    true,
+				Description: "When true, Read treats a key the API reports as DEACTIVE as no longer present and removes it from state, so the next plan recreates it instead of merely showing a status diff. For stricter lifecycles where a deactivated key is as good as gone. Defaults to false.",
+			},
+			"require_ed25519": schema.BoolAttribute{
+				Optional:    true,
+				Description: "When true, ValidateConfig rejects any key whose type isn't ssh-ed25519, for organizations standardizing on it. Defaults to false.",
+			},
+			"recreate_if_deactive_on_read": schema.BoolAttribute{
+				Optional:    true,
+				Description: "When true, ModifyPlan forces a replace instead of an in-place reactivation whenever Read finds the key DEACTIVE, for lifecycles that would rather not reactivate a key that may have been deactivated deliberately (e.g. a leak response). Defaults to false.",
+			},
+			"expires_at": schema.StringAttribute{
+				Optional:    true,
+				Description: "RFC3339 timestamp after which the key should be considered expired. The Indigo API stores this but doesn't enforce anything on it -- enforcement is left to whatever's consuming the key.",
+			},
+			"protect_last_active": schema.BoolAttribute{
+				Optional:    true,
+				Description: "When true, ModifyPlan refuses to plan a destroy for this key if it's the only ACTIVE SSH key left on the account. Defaults to false.",
+			},
+			"min_rsa_bits": schema.Int64Attribute{
+				Optional:    true,
+				Description: "When set, ValidateConfig rejects an ssh-rsa key whose modulus is smaller than this many bits (e.g. 3072 for common compliance baselines). Has no effect on non-RSA keys.",
+			},
+			"report_drift": schema.BoolAttribute{
+				Optional:    true,
+				Description: "When true, Read logs (at info level, via tflog) any difference between the sshkey/status already in state and the fresh values it just fetched, before refreshing them -- useful for feeding alerting off a CI pipeline's `terraform plan -refresh-only` logs. Does not change refresh semantics; state is still updated to match upstream either way. Defaults to false.",
+			},
+			"deactivate_on_destroy": schema.BoolAttribute{
+				Optional:    true,
+				Description: "When true, destroy sets status to DEACTIVE via an update instead of deleting the key, then removes it from state. The key itself is NOT removed from the Indigo account and still counts against any account-level key limit; this trades that for being able to recover the key later without re-registering it. Defaults to false.",
+			},
+			"warn_on_key_truncation": schema.BoolAttribute{
+				Optional:    true,
+				Description: "When true, ModifyPlan emits a plan-time warning if the new sshkey value is contained in the prior value or vice versa -- the shape a truncated copy-paste takes, as opposed to a genuine key rotation. Off by default to avoid false positives on legitimate changes that happen to overlap this way.",
+			},
+			"fail_on_existing_name": schema.BoolAttribute{
+				Optional:    true,
+				Description: "When true, ModifyPlan fails the plan (naming the conflicting id) if a key with this name is already registered upstream, instead of letting Create discover the collision during apply. Ignored when upsert is set. Defaults to false.",
+			},
+		},
+	}
+}
+
+func (r *sshKeyResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	defer func() { exportDiagnostics(r.client, "sshkey.ValidateConfig", resp.Diagnostics) }()
+
+	var config sshKeyResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !config.SSHKey.IsNull() && !config.SSHKeyWO.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("sshkey_wo"),
+			"Conflicting SSH Key Attributes",
+			"sshkey and sshkey_wo are mutually exclusive: set the key body via exactly one of them.",
+		)
+	}
+
+	if !config.SSHKeyWO.IsNull() && config.SSHKeyWOVersion.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("sshkey_wo_version"),
+			"Missing sshkey_wo_version",
+			"sshkey_wo_version must be set alongside sshkey_wo so Terraform can detect when the write-only value changes.",
+		)
+	}
+
+	if config.RequireEd25519.ValueBool() {
+		for _, attr := range []struct {
+			name string
+			v    types.String
+		}{
+			{"sshkey", config.SSHKey},
+			{"sshkey_wo", config.SSHKeyWO},
+		} {
+			if attr.v.IsNull() || attr.v.IsUnknown() {
+				continue
+			}
+			if !strings.HasPrefix(strings.TrimSpace(attr.v.ValueString()), "ssh-ed25519 ") {
+				resp.Diagnostics.AddAttributeError(
+					path.Root(attr.name),
+					"Key Type Not Permitted",
+					"require_ed25519 is set, but "+attr.name+" is not an ssh-ed25519 key.",
+				)
+			}
+		}
+	}
+
+	if minBits := config.MinRSABits.ValueInt64(); minBits > 0 {
+		for _, attr := range []struct {
+			name string
+			v    types.String
+		}{
+			{"sshkey", config.SSHKey},
+			{"sshkey_wo", config.SSHKeyWO},
+		} {
+			if attr.v.IsNull() || attr.v.IsUnknown() {
+				continue
+			}
+			bits, isRSA, err := indigo.RSABitLength(attr.v.ValueString())
+			if err != nil || !isRSA {
+				continue
+			}
+			if int64(bits) < minBits {
+				resp.Diagnostics.AddAttributeError(
+					path.Root(attr.name),
+					"RSA Key Too Small",
+					fmt.Sprintf("min_rsa_bits requires at least %d bits, but %s is a %d-bit ssh-rsa key.", minBits, attr.name, bits),
+				)
+			}
+		}
+	}
+}
+
+func (r *sshKeyResource) ConfigValidators(_ context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		&sshKeyNotBlankValidator{},
+		&sshKeyNameNotSwappedValidator{},
+	}
+}
+
+func (r *sshKeyResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"id": identityschema.StringAttribute{
+				RequiredForImport: true,
+				Description:       "Identifier assigned by the Indigo API. Stable across resource type renames, unlike state, so `moved` blocks can rely on it.",
+			},
+		},
+	}
+}
+
+func (r *sshKeyResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	config, ok := req.ProviderData.(*ProviderConfig)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *provider.ProviderConfig, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = config.Client
+	r.defaultTags = config.DefaultTags
+	r.defaultStatus = config.DefaultStatus
+}
+
+func (r *sshKeyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	defer func() { exportDiagnostics(r.client, "sshkey.Create", resp.Diagnostics) }()
+
+	var plan sshKeyResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Write-only attribute values are only available via Config, never Plan.
+	var config sshKeyResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	plan.SSHKeyWO = config.SSHKeyWO
+
+	// The schema default for status only kicks in when the config omits it
+	// entirely, and always resolves to sshKeyDefaultStatus. A provider-level
+	// default_status lets an environment (e.g. a staging provider block)
+	// override that fallback without every resource block having to repeat
+	// an explicit status.
+	if config.Status.IsNull() && r.defaultStatus != "" {
+		plan.Status = types.StringValue(r.defaultStatus)
+	}
+
+	tags, diags := plan.tagsMap(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tags = mergeDefaultTags(r.defaultTags, tags)
+
+	params := indigo.SSHKeyParams{
+		Name:      plan.Name.ValueString(),
+		SSHKey:    plan.keyBody(),
+		Comment:   plan.Comment.ValueString(),
+		Status:    plan.Status.ValueString(),
+		Tags:      tags,
+		ExpiresAt: plan.ExpiresAt.ValueString(),
+	}
+
+	var key *indigo.SSHKey
+	var err error
+	adopted := false
+	if plan.Upsert.ValueBool() {
+		var existing *indigo.SSHKey
+		existing, err = r.client.FindSSHKeyByName(ctx, plan.Name.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("Unable to Check Existing SSH Key", opError("read", "sshkey", plan.Name.ValueString(), err).Error())
+			return
+		}
+		if existing != nil {
+			// upsert adopts an existing same-name key only when its body is
+			// actually the same key -- otherwise this would silently
+			// repurpose some unrelated key that just happens to share a
+			// name, overwriting its body/comment/status/tags out from under
+			// whatever originally registered it.
+			if !sshKeyBodiesMatch(existing, plan.keyBody()) {
+				resp.Diagnostics.AddError(
+					"SSH Key Name Already Exists With a Different Body",
+					fmt.Sprintf(
+						"upsert is set, and an SSH key named %q already exists (id %s), but its key material does not match the configured sshkey. "+
+							"Refusing to overwrite an unrelated key; use a different name or align sshkey with the existing key.",
+						plan.Name.ValueString(), existing.ID,
+					),
+				)
+				return
+			}
+			adopted = true
+			key, err = r.client.UpdateSSHKey(ctx, existing.ID, params)
+		}
+	}
+	if !adopted {
+		key, err = r.client.CreateSSHKey(ctx, params)
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to Create SSH Key", opError("create", "sshkey", plan.Name.ValueString(), err).Error())
+		return
+	}
+	key, ok := requireResponse(&resp.Diagnostics, key, "Unable to Create SSH Key", "create", "sshkey", plan.Name.ValueString())
+	if !ok {
+		return
+	}
+
+	plan.ID = types.StringValue(key.ID)
+	plan.Fingerprint = types.StringValue(key.Fingerprint)
+	plan.Status = types.StringValue(key.Status)
+	plan.CreatedAt = types.StringValue(key.CreatedAt)
+	plan.UpdatedAt = types.StringValue(key.UpdatedAt)
+	plan.SSHKeyOriginal = types.StringValue(plan.keyBody())
+	plan.SSHKeyWO = types.StringNull()
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, sshKeyResourceIdentityModel{ID: plan.ID})...)
+}
+
+func (r *sshKeyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	defer func() { exportDiagnostics(r.client, "sshkey.Read", resp.Diagnostics) }()
+
+	var state sshKeyResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if r.client.Offline {
+		resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+		return
+	}
+
+	key, err := r.client.GetSSHKey(ctx, state.ID.ValueString())
+	if err != nil {
+		if handleGone(ctx, err, &resp.State) {
+			return
+		}
+		resp.Diagnostics.AddError("Unable to Read SSH Key", opError("read", "sshkey", state.ID.ValueString(), err).Error())
+		return
+	}
+	key, ok := requireResponse(&resp.Diagnostics, key, "Unable to Read SSH Key", "read", "sshkey", state.ID.ValueString())
+	if !ok {
+		return
+	}
+
+	if state.TreatDeactiveAsDeleted.ValueBool() && key.Status == "DEACTIVE" {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	if state.ReportDrift.ValueBool() {
+		logSSHKeyDrift(ctx, r.client, state, key)
+	}
+
+	state.Name = types.StringValue(key.Name)
+	state.Comment = types.StringValue(key.Comment)
+	// Only persist the key body when it wasn't supplied via the write-only
+	// sshkey_wo attribute; write-only mode relies on fingerprint for drift
+	// detection instead, so the key body stays out of state.
+	if !state.SSHKey.IsNull() {
+		state.SSHKey = types.StringValue(key.SSHKey)
+	}
+	state.Fingerprint = types.StringValue(key.Fingerprint)
+	state.Status = types.StringValue(key.Status)
+	state.CreatedAt = types.StringValue(key.CreatedAt)
+	state.UpdatedAt = types.StringValue(key.UpdatedAt)
+	if key.ExpiresAt != "" {
+		state.ExpiresAt = types.StringValue(key.ExpiresAt)
+	} else {
+		state.ExpiresAt = types.StringNull()
+	}
+
+	if len(key.Tags) > 0 {
+		tagsValue, diags := types.MapValueFrom(ctx, types.StringType, key.Tags)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		state.Tags = tagsValue
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, sshKeyResourceIdentityModel{ID: state.ID})...)
+}
+
+func (r *sshKeyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	defer func() { exportDiagnostics(r.client, "sshkey.Update", resp.Diagnostics) }()
+
+	var plan sshKeyResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var priorState sshKeyResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &priorState)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if priorState.Status.ValueString() == "DEACTIVE" && plan.Status.ValueString() == "ACTIVE" {
+		if _, err := r.client.ReactivateSSHKey(ctx, plan.ID.ValueString()); err != nil {
+			resp.Diagnostics.AddError("Unable to Reactivate SSH Key", opError("reactivate", "sshkey", plan.ID.ValueString(), err).Error())
+			return
+		}
+	}
+
+	var config sshKeyResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	plan.SSHKeyWO = config.SSHKeyWO
+
+	tags, diags := plan.tagsMap(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tags = mergeDefaultTags(r.defaultTags, tags)
+
+	key, err := r.client.UpdateSSHKey(ctx, plan.ID.ValueString(), indigo.SSHKeyParams{
+		Name:      plan.Name.ValueString(),
+		SSHKey:    plan.keyBody(),
+		Comment:   plan.Comment.ValueString(),
+		Status:    plan.Status.ValueString(),
+		Tags:      tags,
+		ExpiresAt: plan.ExpiresAt.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to Update SSH Key", opError("update", "sshkey", plan.ID.ValueString(), err).Error())
+		return
+	}
+	key, ok := requireResponse(&resp.Diagnostics, key, "Unable to Update SSH Key", "update", "sshkey", plan.ID.ValueString())
+	if !ok {
+		return
+	}
+
+	if requested := plan.Status.ValueString(); requested != "" && requested != key.Status {
+		resp.Diagnostics.AddError(
+			"SSH Key Status Did Not Match Request",
+			fmt.Sprintf("Requested status %q but the Indigo API reports %q after update. The reported status is what's persisted to state.", requested, key.Status),
+		)
+	}
+
+	plan.Fingerprint = types.StringValue(key.Fingerprint)
+	plan.Status = types.StringValue(key.Status)
+	plan.CreatedAt = types.StringValue(key.CreatedAt)
+	plan.UpdatedAt = types.StringValue(key.UpdatedAt)
+	if key.ExpiresAt != "" {
+		plan.ExpiresAt = types.StringValue(key.ExpiresAt)
+	} else {
+		plan.ExpiresAt = types.StringNull()
+	}
+	if body := plan.keyBody(); body != "" {
+		plan.SSHKeyOriginal = types.StringValue(body)
+	} else {
+		plan.SSHKeyOriginal = priorState.SSHKeyOriginal
+	}
+	plan.SSHKeyWO = types.StringNull()
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *sshKeyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	defer func() { exportDiagnostics(r.client, "sshkey.Delete", resp.Diagnostics) }()
+
+	var state sshKeyResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if state.DeactivateOnDestroy.ValueBool() {
+		if _, err := r.client.UpdateSSHKey(ctx, state.ID.ValueString(), indigo.SSHKeyParams{Name: state.Name.ValueString(), Status: "DEACTIVE"}); err != nil {
+			if indigo.IsNotFound(err) {
+				return
+			}
+			resp.Diagnostics.AddError("Unable to Deactivate SSH Key", opError("update", "sshkey", state.ID.ValueString(), err).Error())
+			return
+		}
+		return
+	}
+
+	if err := r.client.DeleteSSHKey(ctx, state.ID.ValueString()); err != nil {
+		// Deleting an already-deleted key is not an error: the desired end
+		// state (the key does not exist) already holds.
+		if indigo.IsNotFound(err) {
+			return
+		}
+		resp.Diagnostics.AddError("Unable to Delete SSH Key", opError("delete", "sshkey", state.ID.ValueString(), err).Error())
+		return
+	}
+}
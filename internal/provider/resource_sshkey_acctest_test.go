@@ -0,0 +1,88 @@
+package provider_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+
+	"github.com/hakadoriya/terraform-provider-webarena/internal/provider/acctest"
+)
+
+const testRSAKeyA = "ssh-rsa AAAAB3NzaC1yc2EAAAADAQABAAABAQDfpRZZ/H6/RAlEZWJ248F8YZkFcuI+q3o6oVSo/SQgGEnb3vfs4kUTZXykkZGOJ/H9BQPqyn3F+tsBwUDAZidPcbZpNVdyIduHPqObKTO/wlM+3QfExRqb4KvHY1Gmi075LZVkd1t7p/xH05PFj/ynjjsl7s8u1BJ19Cyi8twVcdWJWoMJk1dG7k/xm9uQUMkmicZfMuJNPDMc0oKCeNhj2Sn+BR1SNnv4HrcBHOQ63kBKgdJOYKPhbh6oMfu2sKXp4r8z22YhN2a1NPrvve83/o1xltV6dUJq6KM5qWSNFYV8RL4WxwVwaJjuEdcjl6Rgd4MOZ2XwD1XS1QNfuJuh test-key-a"
+
+const testEd25519KeyB = "ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIErq1/s/kSwuJ45h8lbAmOvxenAO1lYDKvYjdibSmR7z test-key-b"
+
+func sshKeyResourceConfig(endpoint, key string) string {
+	return acctest.ProviderConfig(endpoint) + fmt.Sprintf(`
+resource "webarena_indigo_sshkey" "test" {
+  name   = "acctest-rotate"
+  sshkey = %q
+}
+`, key)
+}
+
+// captureSSHKeyAttrs is a resource.TestCheckFunc that stashes id/fingerprint/
+// updated_at for webarena_indigo_sshkey.test into *got, so a later step can
+// compare its own values against what the prior step saw.
+func captureSSHKeyAttrs(got *map[string]string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources["webarena_indigo_sshkey.test"]
+		if !ok {
+			return fmt.Errorf("resource webarena_indigo_sshkey.test not found in state")
+		}
+		*got = map[string]string{
+			"id":          rs.Primary.Attributes["id"],
+			"fingerprint": rs.Primary.Attributes["fingerprint"],
+			"updated_at":  rs.Primary.Attributes["updated_at"],
+		}
+		return nil
+	}
+}
+
+// TestAccSSHKeyResource_UpdateBodyRotatesFingerprint rotates an sshkey
+// resource's key body in place and asserts fingerprint and updated_at both
+// change to reflect the new key while id stays stable. This is the riskiest
+// update path -- unlike a name/comment/tag change, a key body rotation is
+// also the one an operator most needs confirmed working -- and wasn't
+// exercised by any existing test.
+func TestAccSSHKeyResource_UpdateBodyRotatesFingerprint(t *testing.T) {
+	srv := acctest.NewFakeIndigoServer(acctest.NewFakeSSHKeyHandler())
+	defer srv.Close()
+
+	var before, after map[string]string
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: sshKeyResourceConfig(srv.URL, testRSAKeyA),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("webarena_indigo_sshkey.test", "id"),
+					resource.TestCheckResourceAttrSet("webarena_indigo_sshkey.test", "fingerprint"),
+					resource.TestCheckResourceAttrSet("webarena_indigo_sshkey.test", "updated_at"),
+					captureSSHKeyAttrs(&before),
+				),
+			},
+			{
+				Config: sshKeyResourceConfig(srv.URL, testEd25519KeyB),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					captureSSHKeyAttrs(&after),
+					func(*terraform.State) error {
+						if after["id"] != before["id"] {
+							return fmt.Errorf("id changed across update: before %q, after %q", before["id"], after["id"])
+						}
+						if after["fingerprint"] == before["fingerprint"] {
+							return fmt.Errorf("fingerprint did not change after rotating the key body: still %q", after["fingerprint"])
+						}
+						if after["updated_at"] == before["updated_at"] {
+							return fmt.Errorf("updated_at did not change after rotating the key body: still %q", after["updated_at"])
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,41 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/go-hclog"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/hakadoriya/terraform-provider-webarena/internal/indigo"
+)
+
+// logSSHKeyDrift reports, at info level, any difference between the
+// sshkey/status already in prior (the state Read was called with) and
+// fresh (what the Indigo API just returned), without altering either --
+// Read's normal refresh semantics still apply afterward regardless of what
+// this logs. It's meant to feed monitoring/alerting built around a CI
+// pipeline's `terraform plan -refresh-only` logs, where a silent refresh is
+// exactly the out-of-band change worth knowing about.
+func logSSHKeyDrift(ctx context.Context, client *indigo.Client, prior sshKeyResourceModel, fresh *indigo.SSHKey) {
+	if !prior.SSHKey.IsNull() && prior.SSHKey.ValueString() != fresh.SSHKey {
+		logDriftEvent(ctx, client, prior, "sshkey", prior.SSHKey.ValueString(), fresh.SSHKey)
+	}
+	if prior.Status.ValueString() != fresh.Status {
+		logDriftEvent(ctx, client, prior, "status", prior.Status.ValueString(), fresh.Status)
+	}
+}
+
+func logDriftEvent(ctx context.Context, client *indigo.Client, prior sshKeyResourceModel, attribute, was, now string) {
+	fields := map[string]any{
+		"id":        prior.ID.ValueString(),
+		"attribute": attribute,
+		"was":       was,
+		"now":       now,
+	}
+	if client != nil && client.LogLevel != "" {
+		ctx = tflog.NewSubsystem(ctx, "sshkey_drift", tflog.WithLevel(hclog.LevelFromString(client.LogLevel)))
+		tflog.SubsystemInfo(ctx, "sshkey_drift", "detected drift between state and the Indigo API", fields)
+		return
+	}
+	tflog.Info(ctx, "detected drift between state and the Indigo API", fields)
+}
@@ -0,0 +1,294 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/hakadoriya/terraform-provider-webarena/internal/indigo"
+)
+
+var (
+	_ resource.Resource              = &sshKeyGroupResource{}
+	_ resource.ResourceWithConfigure = &sshKeyGroupResource{}
+)
+
+func NewSSHKeyGroupResource() resource.Resource {
+	return &sshKeyGroupResource{}
+}
+
+// sshKeyGroupResource implements the webarena_indigo_sshkey_group resource: a
+// single resource instance that registers a whole ordered list of public
+// keys under one shared name_prefix, for configs that keep a flat list of
+// authorized keys rather than a name-keyed set (see webarena_indigo_sshkey_set
+// for the latter). Members are tracked by list position, not by content or
+// name, so reordering public_keys is seen as an in-place update of every
+// shifted slot rather than a no-op.
+type sshKeyGroupResource struct {
+	client *indigo.Client
+}
+
+// sshKeyGroupResourceModel maps the sshkey_group resource schema to a Go struct.
+type sshKeyGroupResourceModel struct {
+	NamePrefix types.String `tfsdk:"name_prefix"`
+	PublicKeys types.List   `tfsdk:"public_keys"`
+	IDs        types.List   `tfsdk:"ids"`
+	ID         types.String `tfsdk:"id"`
+}
+
+func (r *sshKeyGroupResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_indigo_sshkey_group"
+}
+
+func (r *sshKeyGroupResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Registers a list of public keys as a single resource, each named by appending its list index to name_prefix. Prefer webarena_indigo_sshkey_set when keys need individually meaningful names, comments, or statuses.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Description: "Synthetic identifier for this managed group; stable for the life of the resource.",
+			},
+			"name_prefix": schema.StringAttribute{
+				Required:    true,
+				Description: "Prefix used to derive each key's name, as \"<name_prefix>-<index>\".",
+			},
+			"public_keys": schema.ListAttribute{
+				Required:    true,
+				ElementType: types.StringType,
+				Description: "Public key material to register, in order. Changing an entry updates that key in place; adding or removing entries creates or deletes the corresponding key.",
+			},
+			"ids": schema.ListAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
+				},
+				Description: "The id the Indigo API assigned each entry in public_keys, in the same order.",
+			},
+		},
+	}
+}
+
+func (r *sshKeyGroupResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	config, ok := req.ProviderData.(*ProviderConfig)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *provider.ProviderConfig, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = config.Client
+}
+
+func (r *sshKeyGroupResource) memberName(prefix string, index int) string {
+	return fmt.Sprintf("%s-%d", prefix, index)
+}
+
+func (r *sshKeyGroupResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan sshKeyGroupResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var publicKeys []string
+	resp.Diagnostics.Append(plan.PublicKeys.ElementsAs(ctx, &publicKeys, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	prefix := plan.NamePrefix.ValueString()
+	// ids is persisted via resp.State.Set below even if a later entry's
+	// CreateSSHKey call fails, so the members already registered aren't
+	// orphaned: without this, an error return with no state set makes
+	// Terraform treat the whole resource as never created, and the next
+	// apply would try to create duplicates for every entry this one already
+	// registered.
+	ids := make([]string, 0, len(publicKeys))
+	for i, publicKey := range publicKeys {
+		name := r.memberName(prefix, i)
+		key, err := r.client.CreateSSHKey(ctx, indigo.SSHKeyParams{Name: name, SSHKey: publicKey})
+		if err != nil {
+			resp.Diagnostics.AddError("Unable to Create SSH Key", opError("create", "sshkey group member", name, err).Error())
+			if idsValue, diags := types.ListValueFrom(ctx, types.StringType, ids); !diags.HasError() {
+				plan.IDs = idsValue
+				plan.ID = types.StringValue(fmt.Sprintf("sshkey_group:%s", prefix))
+				resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+			}
+			return
+		}
+		ids = append(ids, key.ID)
+	}
+
+	idsValue, diags := types.ListValueFrom(ctx, types.StringType, ids)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	plan.IDs = idsValue
+	plan.ID = types.StringValue(fmt.Sprintf("sshkey_group:%s", prefix))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *sshKeyGroupResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state sshKeyGroupResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if r.client.Offline {
+		resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+		return
+	}
+
+	var ids []string
+	resp.Diagnostics.Append(state.IDs.ElementsAs(ctx, &ids, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	for i, id := range ids {
+		if _, err := r.client.GetSSHKey(ctx, id); err != nil {
+			if handleGone(ctx, err, &resp.State) {
+				return
+			}
+			resp.Diagnostics.AddError("Unable to Read SSH Key", opError("read", "sshkey group member", r.memberName(state.NamePrefix.ValueString(), i), err).Error())
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *sshKeyGroupResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan sshKeyGroupResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state sshKeyGroupResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var priorIDs []string
+	resp.Diagnostics.Append(state.IDs.ElementsAs(ctx, &priorIDs, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var publicKeys []string
+	resp.Diagnostics.Append(plan.PublicKeys.ElementsAs(ctx, &publicKeys, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	prefix := plan.NamePrefix.ValueString()
+
+	// ids starts as a copy of priorIDs (not built up from scratch) so that an
+	// entry not yet reached by the loops below keeps its last-known id if
+	// this call returns early on a sibling's error, and is checkpointed via
+	// persistProgress before any such early return. Without this, a failure
+	// partway through orphaned every entry already updated/created/deleted
+	// in the same call: their state was only ever held in this local slice,
+	// discarded on return, so the next apply would retry an update against
+	// an id that had already changed, or create a duplicate for an entry
+	// that already existed.
+	ids := append([]string(nil), priorIDs...)
+
+	persistProgress := func() {
+		idsValue, diags := types.ListValueFrom(ctx, types.StringType, ids)
+		resp.Diagnostics.Append(diags...)
+		if diags.HasError() {
+			return
+		}
+		progress := plan
+		progress.IDs = idsValue
+		progress.ID = types.StringValue(fmt.Sprintf("sshkey_group:%s", prefix))
+		resp.Diagnostics.Append(resp.State.Set(ctx, &progress)...)
+	}
+
+	for i, publicKey := range publicKeys {
+		name := r.memberName(prefix, i)
+		if i < len(priorIDs) {
+			key, err := r.client.UpdateSSHKey(ctx, priorIDs[i], indigo.SSHKeyParams{Name: name, SSHKey: publicKey})
+			if err != nil {
+				resp.Diagnostics.AddError("Unable to Update SSH Key", opError("update", "sshkey group member", name, err).Error())
+				persistProgress()
+				return
+			}
+			ids[i] = key.ID
+			continue
+		}
+
+		key, err := r.client.CreateSSHKey(ctx, indigo.SSHKeyParams{Name: name, SSHKey: publicKey})
+		if err != nil {
+			resp.Diagnostics.AddError("Unable to Create SSH Key", opError("create", "sshkey group member", name, err).Error())
+			persistProgress()
+			return
+		}
+		ids = append(ids, key.ID)
+	}
+
+	for i := len(publicKeys); i < len(priorIDs); i++ {
+		if err := r.client.DeleteSSHKey(ctx, priorIDs[i]); err != nil && !indigo.IsNotFound(err) {
+			resp.Diagnostics.AddError("Unable to Delete SSH Key", opError("delete", "sshkey group member", r.memberName(prefix, i), err).Error())
+			persistProgress()
+			return
+		}
+		// Removes the now-deleted entry from the list's current tail -- the
+		// next undeleted (or not-yet-attempted) entry is always at this same
+		// position once the ones before it have been spliced out.
+		ids = append(ids[:len(publicKeys)], ids[len(publicKeys)+1:]...)
+	}
+
+	idsValue, diags := types.ListValueFrom(ctx, types.StringType, ids)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	plan.IDs = idsValue
+	plan.ID = types.StringValue(fmt.Sprintf("sshkey_group:%s", prefix))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *sshKeyGroupResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state sshKeyGroupResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var ids []string
+	resp.Diagnostics.Append(state.IDs.ElementsAs(ctx, &ids, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	for i, id := range ids {
+		if err := r.client.DeleteSSHKey(ctx, id); err != nil && !indigo.IsNotFound(err) {
+			resp.Diagnostics.AddError("Unable to Delete SSH Key", opError("delete", "sshkey group member", r.memberName(state.NamePrefix.ValueString(), i), err).Error())
+			return
+		}
+	}
+}
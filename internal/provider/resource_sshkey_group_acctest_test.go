@@ -0,0 +1,173 @@
+package provider_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+
+	"github.com/hakadoriya/terraform-provider-webarena/internal/provider/acctest"
+)
+
+// TestAccSSHKeyGroupResource_DiffUpdatesGrowsAndShrinks walks a group through
+// an update that both changes an existing entry in place and appends a new
+// one, then a second update that drops the list back down, covering all
+// three ways public_keys can change relative to the prior ids list.
+func TestAccSSHKeyGroupResource_DiffUpdatesGrowsAndShrinks(t *testing.T) {
+	handler := acctest.NewFakeSSHKeyHandler()
+	srv := acctest.NewFakeIndigoServer(handler)
+	defer srv.Close()
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: acctest.ProviderConfig(srv.URL) + fmt.Sprintf(`
+resource "webarena_indigo_sshkey_group" "test" {
+  name_prefix = "acctest-group"
+  public_keys = [%q]
+}
+`, testRSAKeyA),
+				Check: resource.TestCheckResourceAttr("webarena_indigo_sshkey_group.test", "ids.#", "1"),
+			},
+			{
+				// Entry 0 changes in place and entry 1 is new.
+				Config: acctest.ProviderConfig(srv.URL) + fmt.Sprintf(`
+resource "webarena_indigo_sshkey_group" "test" {
+  name_prefix = "acctest-group"
+  public_keys = [%q, %q]
+}
+`, testEd25519KeyB, testRSAKeyA),
+				Check: resource.TestCheckResourceAttr("webarena_indigo_sshkey_group.test", "ids.#", "2"),
+			},
+			{
+				// Back down to one entry: the second is deleted.
+				Config: acctest.ProviderConfig(srv.URL) + fmt.Sprintf(`
+resource "webarena_indigo_sshkey_group" "test" {
+  name_prefix = "acctest-group"
+  public_keys = [%q]
+}
+`, testEd25519KeyB),
+				Check: resource.TestCheckResourceAttr("webarena_indigo_sshkey_group.test", "ids.#", "1"),
+			},
+		},
+	})
+}
+
+// partialFailureSSHKeyGroupServer is a fake Indigo API that can be told to
+// fail the Nth CreateSSHKey call, so a test can simulate a group apply that
+// registers some entries successfully and then fails partway through.
+type partialFailureSSHKeyGroupServer struct {
+	mu           sync.Mutex
+	nextID       int
+	keys         map[string]map[string]any
+	failOnCreate int // 0 disables; N fails the Nth create call (1-indexed)
+	createCalls  int
+}
+
+func newPartialFailureSSHKeyGroupServer() *partialFailureSSHKeyGroupServer {
+	return &partialFailureSSHKeyGroupServer{keys: map[string]map[string]any{}}
+}
+
+func (s *partialFailureSSHKeyGroupServer) setFailOnCreate(n int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.failOnCreate = n
+}
+
+func (s *partialFailureSSHKeyGroupServer) createCallCount() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.createCalls
+}
+
+func (s *partialFailureSSHKeyGroupServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	path := strings.Trim(strings.TrimPrefix(r.URL.Path, "/sshkeys"), "/")
+	w.Header().Set("Content-Type", "application/json")
+
+	switch {
+	case r.Method == http.MethodPost && path == "":
+		s.createCalls++
+		if s.failOnCreate != 0 && s.createCalls == s.failOnCreate {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		var body map[string]any
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		s.nextID++
+		id := strconv.Itoa(s.nextID)
+		body["id"] = id
+		if _, ok := body["status"]; !ok {
+			body["status"] = "ACTIVE"
+		}
+		s.keys[id] = body
+		_ = json.NewEncoder(w).Encode(body)
+	case r.Method == http.MethodGet && path != "":
+		key, ok := s.keys[path]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_ = json.NewEncoder(w).Encode(key)
+	case r.Method == http.MethodDelete && path != "":
+		if _, ok := s.keys[path]; !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		delete(s.keys, path)
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
+
+// TestAccSSHKeyGroupResource_PartialCreateFailureResumesWithoutDuplicating
+// creates a three-entry group where the third entry fails to register,
+// confirms the apply error surfaces, then clears the failure and re-applies
+// the identical config. The entries that already succeeded must not be
+// created a second time.
+func TestAccSSHKeyGroupResource_PartialCreateFailureResumesWithoutDuplicating(t *testing.T) {
+	srv := newPartialFailureSSHKeyGroupServer()
+	srv.setFailOnCreate(3)
+
+	httpSrv := httptest.NewServer(srv)
+	defer httpSrv.Close()
+
+	config := acctest.ProviderConfig(httpSrv.URL) + fmt.Sprintf(`
+resource "webarena_indigo_sshkey_group" "test" {
+  name_prefix = "acctest-group"
+  public_keys = [%q, %q, %q]
+}
+`, testRSAKeyA, testEd25519KeyB, testRSAKeyA)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config:      config,
+				ExpectError: regexp.MustCompile("Unable to Create SSH Key"),
+			},
+			{
+				PreConfig: func() { srv.setFailOnCreate(0) },
+				Config:    config,
+				Check: func(*terraform.State) error {
+					if got := srv.createCallCount(); got != 4 {
+						return fmt.Errorf("CreateSSHKey was called %d times, want exactly 4 (2 successes, 1 failure, then 1 retry) -- the first two entries must not have been recreated", got)
+					}
+					return nil
+				},
+			},
+		},
+	})
+}
@@ -0,0 +1,74 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+var _ resource.ResourceWithImportState = &sshKeyResource{}
+
+// ImportState accepts a bare id, a "service_id/id" composite for deployments
+// that scope SSH keys under a VM/service, or a "SHA256:..." fingerprint for
+// when the id isn't known but the key material is. service_id carries no
+// meaning in this provider yet and is discarded; accepting and ignoring it
+// keeps import working for state exported from tooling that tracks keys by
+// that composite instead of breaking on the unexpected separator.
+func (r *sshKeyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	id := req.ID
+	if idx := strings.Index(id, "SHA256:"); idx != -1 {
+		// A fingerprint's base64 body can itself contain "/", so it's taken
+		// whole rather than split on the last separator.
+		id = id[idx:]
+	} else if idx := strings.LastIndex(id, "/"); idx != -1 {
+		id = id[idx+1:]
+	}
+
+	if strings.HasPrefix(id, "SHA256:") {
+		resolved, err := r.resolveIDByFingerprint(ctx, id)
+		if err != nil {
+			resp.Diagnostics.AddError("Unable to Import SSH Key", err.Error())
+			return
+		}
+		id = resolved
+	} else if _, err := r.client.GetSSHKey(ctx, id); err != nil {
+		// Fail fast on a typo'd or already-deleted id instead of importing a
+		// resource whose very first Read turns around and reports it gone.
+		resp.Diagnostics.AddError(
+			"Unable to Import SSH Key",
+			opError("read", "sshkey", id, err).Error(),
+		)
+		return
+	}
+
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), resource.ImportStateRequest{ID: id}, resp)
+}
+
+// resolveIDByFingerprint looks up the id of the account's SSH key matching
+// fingerprint, since the Indigo API has no get-by-fingerprint endpoint to
+// delegate to directly.
+func (r *sshKeyResource) resolveIDByFingerprint(ctx context.Context, fingerprint string) (string, error) {
+	keys, err := r.client.ListSSHKeys(ctx)
+	if err != nil {
+		return "", opError("list", "sshkeys", "*", err)
+	}
+
+	var matches []string
+	for _, key := range keys {
+		if key.Fingerprint == fingerprint {
+			matches = append(matches, key.ID)
+		}
+	}
+
+	switch len(matches) {
+	case 0:
+		return "", fmt.Errorf("no SSH key with fingerprint %q was found", fingerprint)
+	case 1:
+		return matches[0], nil
+	default:
+		return "", fmt.Errorf("fingerprint %q matches more than one SSH key (ids: %s); import by id instead", fingerprint, strings.Join(matches, ", "))
+	}
+}
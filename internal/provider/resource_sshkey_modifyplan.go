@@ -0,0 +1,190 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ resource.ResourceWithModifyPlan = &sshKeyResource{}
+
+// ModifyPlan pins fingerprint to its prior value unless the key material
+// itself is changing, so `terraform plan` only shows fingerprint as part of
+// the diff when it's actually meaningful. Without this, fingerprint being
+// Computed makes it show as "(known after apply)" on every update -- even
+// one that only touches name/comment/tags/status -- which buries the one
+// update that matters (a new key body) in noise from the ones that don't.
+func (r *sshKeyResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// default_status replaces the schema's ACTIVE default in the plan itself,
+	// so the planned status already matches what Create sends.
+	if !req.Plan.Raw.IsNull() && r.defaultStatus != "" {
+		var configStatus types.String
+		resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("status"), &configStatus)...)
+		if configStatus.IsNull() {
+			resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("status"), types.StringValue(r.defaultStatus))...)
+		}
+	}
+
+	if req.State.Raw.IsNull() {
+		if !req.Plan.Raw.IsNull() {
+			r.blockExistingNameCollision(ctx, req, resp)
+		}
+		return
+	}
+
+	if req.Plan.Raw.IsNull() {
+		r.blockLastActiveKeyDestroy(ctx, req, resp)
+		return
+	}
+
+	var state, plan sshKeyResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	resp.Diagnostics.Append(resp.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// recreate_if_deactive_on_read trades a deactivated key's usual in-place
+	// reactivation for a full replace, for lifecycles that would rather
+	// treat deactivation as destructive than risk reactivating a key that
+	// may have been deactivated for a reason (e.g. a leak response).
+	if shouldRecreateForDeactive(plan.RecreateIfDeactiveOnRead.ValueBool(), state.Status.ValueString(), plan.Status.ValueString()) {
+		resp.RequiresReplace = append(resp.RequiresReplace, path.Root("status"))
+		return
+	}
+
+	// A plan value can be Unknown (e.g. sshkey_wo_version interpolated from
+	// another resource's not-yet-known attribute); ValueString() silently
+	// returns "" for those instead of panicking, which would wrongly compare
+	// equal to a prior empty string and pin fingerprint when the key may in
+	// fact be about to change. Treat Unknown as "changed" so pinning only
+	// ever happens when both sides are concretely known and equal.
+	keyChanged := !stringValuesEqual(plan.SSHKeyWOVersion, state.SSHKeyWOVersion) ||
+		!stringValuesEqual(plan.SSHKey, state.SSHKey)
+	if keyChanged {
+		if plan.WarnOnKeyTruncation.ValueBool() {
+			warnIfKeyLooksTruncated(resp, state.SSHKeyOriginal.ValueString(), plan.keyBody())
+		}
+		return
+	}
+
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("fingerprint"), state.Fingerprint)...)
+}
+
+// shouldRecreateForDeactive reports whether recreate_if_deactive_on_read
+// should force a replace for a key Read found DEACTIVE. Gated on the plan
+// NOT also wanting DEACTIVE: a user who explicitly sets status = "DEACTIVE"
+// in config is asking to stay deactivated, and state.Status reading back
+// DEACTIVE again after every recreate would otherwise force a replace on
+// every single plan, forever, instead of ever converging.
+func shouldRecreateForDeactive(recreateIfDeactiveOnRead bool, stateStatus, planStatus string) bool {
+	return recreateIfDeactiveOnRead && stateStatus == "DEACTIVE" && planStatus != "DEACTIVE"
+}
+
+// warnIfKeyLooksTruncated warns when one of old and new contains the other as
+// a substring -- the shape a truncated copy-paste takes (losing bytes at
+// either end) or its mirror image (stray extra characters), as distinct from
+// a genuine key rotation, which produces two keys with no such relationship.
+// Opt-in via warn_on_key_truncation: some legitimate rotations (e.g. adding a
+// trailing comment to the same key) can also look like one string containing
+// the other, so this stays a warning rather than an error, and off by
+// default to avoid surprising existing configs with new plan-time noise.
+func warnIfKeyLooksTruncated(resp *resource.ModifyPlanResponse, old, updated string) {
+	old = strings.TrimSpace(old)
+	updated = strings.TrimSpace(updated)
+	if old == "" || updated == "" || old == updated {
+		return
+	}
+	if strings.Contains(old, updated) || strings.Contains(updated, old) {
+		resp.Diagnostics.AddAttributeWarning(
+			path.Root("sshkey"),
+			"Possible Truncated or Partial Key Paste",
+			"The new sshkey value is contained in the prior value (or vice versa), which is the shape a truncated copy-paste takes rather than a genuine key rotation. "+
+				"If this is intentional, you can ignore this warning.",
+		)
+	}
+}
+
+// blockExistingNameCollision fails the plan, before apply ever runs, when
+// fail_on_existing_name is set and an SSH key with the same name is already
+// registered upstream. Without this, the collision is only discovered when
+// Create's CreateSSHKey call fails partway through apply, leaving whatever
+// else was in the same apply half-applied. Gated behind fail_on_existing_name
+// (and skipped when upsert is set, since upsert's whole point is to adopt
+// that existing key rather than treat it as an error) so existing configs
+// that rely on the API's own duplicate-name behavior are unaffected.
+func (r *sshKeyResource) blockExistingNameCollision(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	var plan sshKeyResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !plan.FailOnExistingName.ValueBool() || plan.Upsert.ValueBool() {
+		return
+	}
+	if plan.Name.IsUnknown() {
+		return
+	}
+
+	existing, err := r.client.FindSSHKeyByName(ctx, plan.Name.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddWarning(
+			"Unable to Check for an Existing SSH Key with This Name",
+			"fail_on_existing_name is set, but checking for a name collision failed, so the plan is being allowed to proceed: "+err.Error(),
+		)
+		return
+	}
+	if existing != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("name"),
+			"SSH Key Name Already Exists",
+			fmt.Sprintf("fail_on_existing_name is set, and an SSH key named %q already exists (id %s). "+
+				"Set upsert instead if it should be adopted, or choose a different name.", plan.Name.ValueString(), existing.ID),
+		)
+	}
+}
+
+// blockLastActiveKeyDestroy refuses to plan a destroy for an ACTIVE key with
+// protect_last_active set if it's the only ACTIVE key left on the account,
+// since that would lock out whatever provisioning relies on at least one
+// registered key existing (e.g. new VM creation).
+func (r *sshKeyResource) blockLastActiveKeyDestroy(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	var state sshKeyResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !state.ProtectLastActive.ValueBool() || state.Status.ValueString() != "ACTIVE" {
+		return
+	}
+
+	keys, err := r.client.ListSSHKeys(ctx)
+	if err != nil {
+		resp.Diagnostics.AddWarning(
+			"Unable to Check Whether This Is the Last Active Key",
+			"protect_last_active is set, but listing SSH keys to check failed, so the destroy is being allowed to proceed: "+err.Error(),
+		)
+		return
+	}
+
+	activeCount := 0
+	for _, key := range keys {
+		if key.Status == "ACTIVE" {
+			activeCount++
+		}
+	}
+
+	if activeCount <= 1 {
+		resp.Diagnostics.AddError(
+			"Refusing to Destroy the Last Active SSH Key",
+			"protect_last_active is set on this resource, and it is the only ACTIVE SSH key on the account. "+
+				"Register a replacement key before destroying this one, or remove protect_last_active if this is intentional.",
+		)
+	}
+}
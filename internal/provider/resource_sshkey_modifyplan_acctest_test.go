@@ -0,0 +1,68 @@
+package provider_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/hakadoriya/terraform-provider-webarena/internal/provider/acctest"
+)
+
+// TestAccSSHKeyResource_FailOnExistingNameBlocksAtPlan pre-seeds the fake
+// server with a key already registered under the planned name, then
+// confirms fail_on_existing_name fails the plan itself -- naming the
+// conflicting id -- instead of letting it through to a failed apply.
+func TestAccSSHKeyResource_FailOnExistingNameBlocksAtPlan(t *testing.T) {
+	handler := acctest.NewFakeSSHKeyHandler()
+	existingID := handler.Seed("acctest-collision", testRSAKeyA)
+
+	srv := acctest.NewFakeIndigoServer(handler)
+	defer srv.Close()
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: acctest.ProviderConfig(srv.URL) + fmt.Sprintf(`
+resource "webarena_indigo_sshkey" "test" {
+  name                  = "acctest-collision"
+  sshkey                = %q
+  fail_on_existing_name = true
+}
+`, testEd25519KeyB),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(regexp.QuoteMeta(existingID)),
+			},
+		},
+	})
+}
+
+// TestAccSSHKeyResource_RecreateIfDeactiveOnReadConvergesOnDeactiveConfig
+// creates a key with status = "DEACTIVE" and recreate_if_deactive_on_read set,
+// then relies on the framework's automatic post-apply plan check to confirm
+// the plan comes back empty. Before shouldRecreateForDeactive also checked
+// what the plan wants, Read reporting the key back as DEACTIVE on every
+// refresh forced a replace here regardless, so a config that explicitly asks
+// to stay deactivated could never converge.
+func TestAccSSHKeyResource_RecreateIfDeactiveOnReadConvergesOnDeactiveConfig(t *testing.T) {
+	srv := acctest.NewFakeIndigoServer(acctest.NewFakeSSHKeyHandler())
+	defer srv.Close()
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: acctest.ProviderConfig(srv.URL) + fmt.Sprintf(`
+resource "webarena_indigo_sshkey" "test" {
+  name                         = "acctest-deactive-converge"
+  sshkey                       = %q
+  status                       = "DEACTIVE"
+  recreate_if_deactive_on_read = true
+}
+`, testRSAKeyA),
+			},
+		},
+	})
+}
@@ -0,0 +1,50 @@
+package provider
+
+import "testing"
+
+// TestShouldRecreateForDeactive exercises the decision
+// recreate_if_deactive_on_read's ModifyPlan branch relies on, including the
+// config = "DEACTIVE" case that would otherwise force a replace on every
+// single plan, forever, since Read always reports DEACTIVE back.
+func TestShouldRecreateForDeactive(t *testing.T) {
+	tests := map[string]struct {
+		recreateIfDeactiveOnRead bool
+		stateStatus              string
+		planStatus               string
+		want                     bool
+	}{
+		"flag off, state deactive": {
+			recreateIfDeactiveOnRead: false,
+			stateStatus:              "DEACTIVE",
+			planStatus:               "ACTIVE",
+			want:                     false,
+		},
+		"flag on, state active": {
+			recreateIfDeactiveOnRead: true,
+			stateStatus:              "ACTIVE",
+			planStatus:               "ACTIVE",
+			want:                     false,
+		},
+		"flag on, state deactive, plan wants active": {
+			recreateIfDeactiveOnRead: true,
+			stateStatus:              "DEACTIVE",
+			planStatus:               "ACTIVE",
+			want:                     true,
+		},
+		"flag on, state deactive, plan also wants deactive": {
+			recreateIfDeactiveOnRead: true,
+			stateStatus:              "DEACTIVE",
+			planStatus:               "DEACTIVE",
+			want:                     false, // the bug: must not loop forever on a config that asks to stay deactivated
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			if got := shouldRecreateForDeactive(tc.recreateIfDeactiveOnRead, tc.stateStatus, tc.planStatus); got != tc.want {
+				t.Fatalf("shouldRecreateForDeactive(%v, %q, %q) = %v, want %v",
+					tc.recreateIfDeactiveOnRead, tc.stateStatus, tc.planStatus, got, tc.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,84 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ resource.ResourceWithMoveState = &sshKeyResource{}
+
+// communitySSHKeyProviderAddress is the source address of the unofficial
+// community provider this resource knows how to adopt state from via a
+// `moved` block, e.g.:
+//
+//	moved {
+//	  from = module.old.webarena_sshkey.example
+//	  to   = webarena_indigo_sshkey.example
+//	}
+const communitySSHKeyProviderAddress = "registry.terraform.io/webarena-community/webarena"
+
+// communitySSHKeyResourceState is the subset of the community provider's
+// webarena_sshkey state this resource knows how to translate. The community
+// schema has no identity block and no write-only/tags support, so anything
+// beyond these fields is simply left at this resource's zero values and
+// picked up on the next Read.
+type communitySSHKeyResourceState struct {
+	ID      string `json:"id"`
+	Name    string `json:"name"`
+	SSHKey  string `json:"sshkey"`
+	Comment string `json:"comment"`
+	Status  string `json:"status"`
+}
+
+// MoveState lets practitioners adopt keys tracked by the older,
+// community-maintained webarena_sshkey resource into this resource via a
+// `moved` block, without a destroy/recreate cycle.
+func (r *sshKeyResource) MoveState(context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		{
+			// SourceSchema is left nil: the community provider's schema isn't
+			// available to us, so we parse the source state's raw JSON
+			// ourselves instead of asking the framework to decode it against
+			// a known schema.
+			StateMover: func(ctx context.Context, req resource.MoveStateRequest, resp *resource.MoveStateResponse) {
+				if req.SourceProviderAddress != communitySSHKeyProviderAddress || req.SourceTypeName != "webarena_sshkey" {
+					return
+				}
+				if req.SourceRawState == nil {
+					return
+				}
+
+				var src communitySSHKeyResourceState
+				if err := json.Unmarshal(req.SourceRawState.JSON, &src); err != nil {
+					resp.Diagnostics.AddError(
+						"Unable to Adopt Community SSH Key State",
+						"Failed to parse the source resource's state: "+err.Error(),
+					)
+					return
+				}
+
+				status := src.Status
+				if status == "" {
+					status = sshKeyDefaultStatus
+				}
+
+				target := sshKeyResourceModel{
+					ID:              types.StringValue(src.ID),
+					Name:            types.StringValue(src.Name),
+					SSHKey:          types.StringValue(src.SSHKey),
+					SSHKeyWO:        types.StringNull(),
+					SSHKeyWOVersion: types.StringNull(),
+					Comment:         types.StringValue(src.Comment),
+					Tags:            types.MapNull(types.StringType),
+					Fingerprint:     types.StringValue(""),
+					Status:          types.StringValue(status),
+				}
+
+				resp.Diagnostics.Append(resp.TargetState.Set(ctx, &target)...)
+			},
+		},
+	}
+}
@@ -0,0 +1,144 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// newMoveStateTestTarget builds the zero-value TargetState the framework
+// itself hands StateMover implementations: Schema set to the target
+// resource's own schema, Raw a null value of that schema's type.
+func newMoveStateTestTarget(ctx context.Context, t *testing.T, r *sshKeyResource) tfsdk.State {
+	t.Helper()
+
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+	if schemaResp.Diagnostics.HasError() {
+		t.Fatalf("building schema: %v", schemaResp.Diagnostics)
+	}
+
+	return tfsdk.State{
+		Schema: schemaResp.Schema,
+		Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
+	}
+}
+
+// TestSSHKeyResourceMoveState_AdoptsCommunityProviderState exercises the
+// communitySSHKeyResourceState -> sshKeyResourceModel mapping with a
+// synthetic source state, confirming a key moved from the community
+// webarena_sshkey resource lands with its fields translated and status
+// preserved.
+func TestSSHKeyResourceMoveState_AdoptsCommunityProviderState(t *testing.T) {
+	ctx := context.Background()
+	r := &sshKeyResource{}
+
+	movers := r.MoveState(ctx)
+	if len(movers) != 1 {
+		t.Fatalf("MoveState returned %d movers, want 1", len(movers))
+	}
+
+	req := resource.MoveStateRequest{
+		SourceProviderAddress: communitySSHKeyProviderAddress,
+		SourceTypeName:        "webarena_sshkey",
+		SourceRawState: &tfprotov6.RawState{
+			JSON: []byte(`{"id":"123","name":"legacy-key","sshkey":"ssh-rsa AAAA legacy","comment":"migrated","status":"DEACTIVE"}`),
+		},
+	}
+	resp := &resource.MoveStateResponse{
+		TargetState: newMoveStateTestTarget(ctx, t, r),
+	}
+
+	movers[0].StateMover(ctx, req, resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("StateMover returned unexpected diagnostics: %v", resp.Diagnostics)
+	}
+
+	var got sshKeyResourceModel
+	if diags := resp.TargetState.Get(ctx, &got); diags.HasError() {
+		t.Fatalf("reading back TargetState: %v", diags)
+	}
+
+	if got.ID.ValueString() != "123" {
+		t.Errorf("ID = %q, want %q", got.ID.ValueString(), "123")
+	}
+	if got.Name.ValueString() != "legacy-key" {
+		t.Errorf("Name = %q, want %q", got.Name.ValueString(), "legacy-key")
+	}
+	if got.SSHKey.ValueString() != "ssh-rsa AAAA legacy" {
+		t.Errorf("SSHKey = %q, want %q", got.SSHKey.ValueString(), "ssh-rsa AAAA legacy")
+	}
+	if got.Comment.ValueString() != "migrated" {
+		t.Errorf("Comment = %q, want %q", got.Comment.ValueString(), "migrated")
+	}
+	if got.Status.ValueString() != "DEACTIVE" {
+		t.Errorf("Status = %q, want %q", got.Status.ValueString(), "DEACTIVE")
+	}
+	if !got.Tags.IsNull() {
+		t.Errorf("Tags = %v, want null (community schema has no tags)", got.Tags)
+	}
+}
+
+// TestSSHKeyResourceMoveState_DefaultsMissingStatus confirms a source state
+// with no status field (the community schema predates status tracking)
+// defaults to sshKeyDefaultStatus rather than moving in with an empty status.
+func TestSSHKeyResourceMoveState_DefaultsMissingStatus(t *testing.T) {
+	ctx := context.Background()
+	r := &sshKeyResource{}
+
+	req := resource.MoveStateRequest{
+		SourceProviderAddress: communitySSHKeyProviderAddress,
+		SourceTypeName:        "webarena_sshkey",
+		SourceRawState: &tfprotov6.RawState{
+			JSON: []byte(`{"id":"456","name":"no-status-key","sshkey":"ssh-rsa AAAA","comment":""}`),
+		},
+	}
+	resp := &resource.MoveStateResponse{
+		TargetState: newMoveStateTestTarget(ctx, t, r),
+	}
+
+	r.MoveState(ctx)[0].StateMover(ctx, req, resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("StateMover returned unexpected diagnostics: %v", resp.Diagnostics)
+	}
+
+	var got sshKeyResourceModel
+	if diags := resp.TargetState.Get(ctx, &got); diags.HasError() {
+		t.Fatalf("reading back TargetState: %v", diags)
+	}
+	if got.Status.ValueString() != sshKeyDefaultStatus {
+		t.Errorf("Status = %q, want default %q", got.Status.ValueString(), sshKeyDefaultStatus)
+	}
+}
+
+// TestSSHKeyResourceMoveState_IgnoresUnrelatedSource confirms a request from
+// a provider/type this resource doesn't know how to adopt from is left
+// unhandled (no TargetState, no diagnostics), so the framework can report its
+// own "implementation not found" error instead of a confusing partial move.
+func TestSSHKeyResourceMoveState_IgnoresUnrelatedSource(t *testing.T) {
+	ctx := context.Background()
+	r := &sshKeyResource{}
+
+	req := resource.MoveStateRequest{
+		SourceProviderAddress: "registry.terraform.io/hashicorp/random",
+		SourceTypeName:        "random_pet",
+		SourceRawState: &tfprotov6.RawState{
+			JSON: []byte(`{"id":"789"}`),
+		},
+	}
+	resp := &resource.MoveStateResponse{
+		TargetState: newMoveStateTestTarget(ctx, t, r),
+	}
+
+	r.MoveState(ctx)[0].StateMover(ctx, req, resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("StateMover returned unexpected diagnostics for an unrelated source: %v", resp.Diagnostics)
+	}
+	if !resp.TargetState.Raw.IsNull() {
+		t.Error("TargetState was populated for a source this resource doesn't recognize")
+	}
+}
@@ -0,0 +1,349 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/hakadoriya/terraform-provider-webarena/internal/indigo"
+)
+
+var (
+	_ resource.Resource              = &sshKeySetResource{}
+	_ resource.ResourceWithConfigure = &sshKeySetResource{}
+)
+
+func NewSSHKeySetResource() resource.Resource {
+	return &sshKeySetResource{}
+}
+
+// sshKeySetResource implements the webarena_indigo_sshkey_set resource: a
+// single resource instance that manages a whole set of SSH keys together.
+// It exists for configs that build the set of keys with for_each/a dynamic
+// expression and would rather manage one set-typed attribute than one
+// webarena_indigo_sshkey resource instance per key.
+type sshKeySetResource struct {
+	client *indigo.Client
+}
+
+// sshKeySetEntryModel is one key within the keys set. Entries are compared
+// for set membership by their full object value, so name must be unique
+// within a given set (it's also the key used for the ids output map) but
+// otherwise carries no special meaning to Terraform.
+type sshKeySetEntryModel struct {
+	Name    types.String `tfsdk:"name"`
+	SSHKey  types.String `tfsdk:"sshkey"`
+	Comment types.String `tfsdk:"comment"`
+	Status  types.String `tfsdk:"status"`
+}
+
+// sshKeySetResourceModel maps the sshkey_set resource schema to a Go struct.
+type sshKeySetResourceModel struct {
+	Keys types.Set    `tfsdk:"keys"`
+	IDs  types.Map    `tfsdk:"ids"`
+	ID   types.String `tfsdk:"id"`
+}
+
+func (r *sshKeySetResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_indigo_sshkey_set"
+}
+
+func (r *sshKeySetResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a set of SSH keys as a single resource, for configs that build the set of keys to register with for_each/a dynamic expression rather than declaring one webarena_indigo_sshkey resource per key.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Description: "Synthetic identifier for this managed set; stable for the life of the resource.",
+			},
+			"keys": schema.SetNestedAttribute{
+				Required:    true,
+				Description: "The SSH keys to register. name must be unique within the set.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Required:    true,
+							Description: "Name used to identify the key in the Indigo control panel, and as the key in the ids output map.",
+						},
+						"sshkey": schema.StringAttribute{
+							Required:    true,
+							Description: "The public key material.",
+						},
+						"comment": schema.StringAttribute{
+							Optional:    true,
+							Description: "Comment to associate with the key.",
+						},
+						"status": schema.StringAttribute{
+							Optional:    true,
+							Description: "Desired status of the key (ACTIVE or DEACTIVE). Defaults to ACTIVE if unset.",
+						},
+					},
+				},
+			},
+			"ids": schema.MapAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.UseStateForUnknown(),
+				},
+				Description: "Map from each key's name to the id the Indigo API assigned it.",
+			},
+		},
+	}
+}
+
+func (r *sshKeySetResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	config, ok := req.ProviderData.(*ProviderConfig)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *provider.ProviderConfig, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = config.Client
+}
+
+func (m sshKeySetResourceModel) entries(ctx context.Context) ([]sshKeySetEntryModel, diag.Diagnostics) {
+	var entries []sshKeySetEntryModel
+	diags := m.Keys.ElementsAs(ctx, &entries, false)
+	return entries, diags
+}
+
+func (r *sshKeySetResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan sshKeySetResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	entries, diags := plan.entries(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// No logBatchPlan call here: unlike UpdateRequest, CreateRequest carries no
+	// Private field -- the framework doesn't thread ModifyPlan's planned
+	// private state through to Create, since there's no prior private state
+	// for a resource that doesn't exist yet. The batch plan is only
+	// observable once it shows up in Update.
+
+	// ids is persisted via resp.State.Set below even when a later member's
+	// CreateSSHKey call fails, so the members that already succeeded aren't
+	// orphaned: without this, an error return with no state set makes
+	// Terraform treat the whole resource as never created, and the next
+	// apply would create duplicates for every member this one already
+	// registered.
+	ids := map[string]string{}
+	for _, entry := range entries {
+		key, err := r.client.CreateSSHKey(ctx, indigo.SSHKeyParams{
+			Name:    entry.Name.ValueString(),
+			SSHKey:  entry.SSHKey.ValueString(),
+			Comment: entry.Comment.ValueString(),
+			Status:  entry.Status.ValueString(),
+		})
+		if err != nil {
+			resp.Diagnostics.AddError("Unable to Create SSH Key", opError("create", "sshkey set member", entry.Name.ValueString(), err).Error())
+			if idsValue, diags := types.MapValueFrom(ctx, types.StringType, ids); !diags.HasError() {
+				plan.IDs = idsValue
+				plan.ID = types.StringValue(fmt.Sprintf("sshkey_set:%d", len(ids)))
+				resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+			}
+			return
+		}
+		ids[entry.Name.ValueString()] = key.ID
+	}
+
+	idsValue, diags := types.MapValueFrom(ctx, types.StringType, ids)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	plan.IDs = idsValue
+	plan.ID = types.StringValue(fmt.Sprintf("sshkey_set:%d", len(ids)))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *sshKeySetResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state sshKeySetResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if r.client.Offline {
+		resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+		return
+	}
+
+	var ids map[string]string
+	resp.Diagnostics.Append(state.IDs.ElementsAs(ctx, &ids, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	for name, id := range ids {
+		if _, err := r.client.GetSSHKey(ctx, id); err != nil {
+			if handleGone(ctx, err, &resp.State) {
+				return
+			}
+			resp.Diagnostics.AddError("Unable to Read SSH Key", opError("read", "sshkey set member", name, err).Error())
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *sshKeySetResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan sshKeySetResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state sshKeySetResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var priorIDs map[string]string
+	resp.Diagnostics.Append(state.IDs.ElementsAs(ctx, &priorIDs, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	entries, diags := plan.entries(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if raw, privDiags := req.Private.GetKey(ctx, privateKeyBatchPlan); !privDiags.HasError() {
+		logBatchPlan(ctx, r.client, raw)
+	}
+
+	wanted := map[string]sshKeySetEntryModel{}
+	for _, entry := range entries {
+		wanted[entry.Name.ValueString()] = entry
+	}
+
+	// Seeded from priorIDs (not built up from scratch) so that a member not
+	// yet reached by the loop below keeps its last-known id in state rather
+	// than being dropped if this call returns early on a sibling's error.
+	ids := map[string]string{}
+	for name, id := range priorIDs {
+		ids[name] = id
+	}
+
+	// persistProgress checkpoints whatever members have already been created,
+	// updated, or deleted so far in this call before an error aborts the rest
+	// of the batch. Without it, a failure partway through orphaned every
+	// member that had already succeeded: their new ids were only ever held in
+	// the local ids map, which was discarded on return, so state still named
+	// their old (for updates) or no (for creates) id, and the next apply
+	// would create a duplicate or try to update an id that no longer existed.
+	persistProgress := func() {
+		idsValue, diags := types.MapValueFrom(ctx, types.StringType, ids)
+		resp.Diagnostics.Append(diags...)
+		if diags.HasError() {
+			return
+		}
+		progress := plan
+		progress.IDs = idsValue
+		progress.ID = types.StringValue(fmt.Sprintf("sshkey_set:%d", len(ids)))
+		resp.Diagnostics.Append(resp.State.Set(ctx, &progress)...)
+	}
+
+	for name, entry := range wanted {
+		if id, ok := priorIDs[name]; ok {
+			key, err := r.client.UpdateSSHKey(ctx, id, indigo.SSHKeyParams{
+				Name:    entry.Name.ValueString(),
+				SSHKey:  entry.SSHKey.ValueString(),
+				Comment: entry.Comment.ValueString(),
+				Status:  entry.Status.ValueString(),
+			})
+			if err != nil {
+				resp.Diagnostics.AddError("Unable to Update SSH Key", opError("update", "sshkey set member", name, err).Error())
+				persistProgress()
+				return
+			}
+			ids[name] = key.ID
+			continue
+		}
+
+		key, err := r.client.CreateSSHKey(ctx, indigo.SSHKeyParams{
+			Name:    entry.Name.ValueString(),
+			SSHKey:  entry.SSHKey.ValueString(),
+			Comment: entry.Comment.ValueString(),
+			Status:  entry.Status.ValueString(),
+		})
+		if err != nil {
+			resp.Diagnostics.AddError("Unable to Create SSH Key", opError("create", "sshkey set member", name, err).Error())
+			persistProgress()
+			return
+		}
+		ids[name] = key.ID
+	}
+
+	for name, id := range priorIDs {
+		if _, ok := wanted[name]; ok {
+			continue
+		}
+		if err := r.client.DeleteSSHKey(ctx, id); err != nil && !indigo.IsNotFound(err) {
+			resp.Diagnostics.AddError("Unable to Delete SSH Key", opError("delete", "sshkey set member", name, err).Error())
+			persistProgress()
+			return
+		}
+		delete(ids, name)
+	}
+
+	idsValue, diags := types.MapValueFrom(ctx, types.StringType, ids)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	plan.IDs = idsValue
+	plan.ID = types.StringValue(fmt.Sprintf("sshkey_set:%d", len(ids)))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *sshKeySetResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state sshKeySetResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var ids map[string]string
+	resp.Diagnostics.Append(state.IDs.ElementsAs(ctx, &ids, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	for name, id := range ids {
+		if err := r.client.DeleteSSHKey(ctx, id); err != nil && !indigo.IsNotFound(err) {
+			resp.Diagnostics.AddError("Unable to Delete SSH Key", opError("delete", "sshkey set member", name, err).Error())
+			return
+		}
+	}
+}
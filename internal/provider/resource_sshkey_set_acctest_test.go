@@ -0,0 +1,158 @@
+package provider_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+
+	"github.com/hakadoriya/terraform-provider-webarena/internal/provider/acctest"
+)
+
+// partialFailureSSHKeySetServer is a fake Indigo API that can be told to fail
+// creating one particular key name, so a test can simulate a batch apply that
+// registers some members of a webarena_indigo_sshkey_set successfully and
+// then fails partway through on another.
+type partialFailureSSHKeySetServer struct {
+	mu            sync.Mutex
+	nextID        int
+	keys          map[string]map[string]any
+	failCreateFor string
+	failEnabled   bool
+	createCalls   map[string]int
+}
+
+func newPartialFailureSSHKeySetServer() *partialFailureSSHKeySetServer {
+	return &partialFailureSSHKeySetServer{
+		keys:        map[string]map[string]any{},
+		createCalls: map[string]int{},
+	}
+}
+
+func (s *partialFailureSSHKeySetServer) setFailCreate(name string, enabled bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.failCreateFor = name
+	s.failEnabled = enabled
+}
+
+func (s *partialFailureSSHKeySetServer) createCallCount(name string) int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.createCalls[name]
+}
+
+func (s *partialFailureSSHKeySetServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	path := strings.Trim(strings.TrimPrefix(r.URL.Path, "/sshkeys"), "/")
+	w.Header().Set("Content-Type", "application/json")
+
+	switch {
+	case r.Method == http.MethodPost && path == "":
+		var body map[string]any
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		name, _ := body["name"].(string)
+		s.createCalls[name]++
+		if s.failEnabled && name == s.failCreateFor {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		s.nextID++
+		id := strconv.Itoa(s.nextID)
+		body["id"] = id
+		if _, ok := body["status"]; !ok {
+			body["status"] = "ACTIVE"
+		}
+		s.keys[id] = body
+		_ = json.NewEncoder(w).Encode(body)
+	case r.Method == http.MethodPut && path != "":
+		key, ok := s.keys[path]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		var body map[string]any
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		for k, v := range body {
+			key[k] = v
+		}
+		s.keys[path] = key
+		_ = json.NewEncoder(w).Encode(key)
+	case r.Method == http.MethodGet && path != "":
+		key, ok := s.keys[path]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_ = json.NewEncoder(w).Encode(key)
+	case r.Method == http.MethodDelete && path != "":
+		if _, ok := s.keys[path]; !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		delete(s.keys, path)
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
+
+// TestAccSSHKeySetResource_PartialCreateFailureResumesWithoutDuplicating
+// creates a three-member set where the third member fails to register,
+// confirms the apply error surfaces, then clears the failure and re-applies
+// the identical config. The members that already succeeded on the first
+// apply must not be created a second time -- only the one that failed should
+// see a second CreateSSHKey call.
+func TestAccSSHKeySetResource_PartialCreateFailureResumesWithoutDuplicating(t *testing.T) {
+	srv := newPartialFailureSSHKeySetServer()
+	srv.setFailCreate("charlie", true)
+
+	httpSrv := httptest.NewServer(srv)
+	defer httpSrv.Close()
+
+	config := acctest.ProviderConfig(httpSrv.URL) + fmt.Sprintf(`
+resource "webarena_indigo_sshkey_set" "test" {
+  keys = [
+    { name = "alice", sshkey = %q },
+    { name = "bob", sshkey = %q },
+    { name = "charlie", sshkey = %q },
+  ]
+}
+`, testRSAKeyA, testEd25519KeyB, testRSAKeyA)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config:      config,
+				ExpectError: regexp.MustCompile("Unable to Create SSH Key"),
+			},
+			{
+				PreConfig: func() { srv.setFailCreate("charlie", false) },
+				Config:    config,
+				Check: func(*terraform.State) error {
+					if got := srv.createCallCount("alice"); got != 1 {
+						return fmt.Errorf("alice was created %d times, want exactly 1 (the failed apply must not have been retried for members that already succeeded)", got)
+					}
+					if got := srv.createCallCount("bob"); got != 1 {
+						return fmt.Errorf("bob was created %d times, want exactly 1 (the failed apply must not have been retried for members that already succeeded)", got)
+					}
+					if got := srv.createCallCount("charlie"); got != 2 {
+						return fmt.Errorf("charlie was created %d times, want exactly 2 (the first failed attempt plus the successful resume)", got)
+					}
+					return nil
+				},
+			},
+		},
+	})
+}
@@ -0,0 +1,59 @@
+package provider_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/hakadoriya/terraform-provider-webarena/internal/provider/acctest"
+)
+
+// TestAccSSHKeySetResource_DiffAddsUpdatesAndRemovesMembers exercises the
+// three branches of Update's diff against priorIDs in one sequence: a member
+// present in both the old and new config is updated in place and keeps its
+// id, a member only in the new config is created, and a member only in the
+// old config is deleted upstream and dropped from the ids map.
+func TestAccSSHKeySetResource_DiffAddsUpdatesAndRemovesMembers(t *testing.T) {
+	handler := acctest.NewFakeSSHKeyHandler()
+	srv := acctest.NewFakeIndigoServer(handler)
+	defer srv.Close()
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: acctest.ProviderConfig(srv.URL) + fmt.Sprintf(`
+resource "webarena_indigo_sshkey_set" "test" {
+  keys = [
+    { name = "alice", sshkey = %q, comment = "original" },
+    { name = "bob", sshkey = %q },
+  ]
+}
+`, testRSAKeyA, testEd25519KeyB),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("webarena_indigo_sshkey_set.test", "ids.alice"),
+					resource.TestCheckResourceAttrSet("webarena_indigo_sshkey_set.test", "ids.bob"),
+				),
+			},
+			{
+				// bob is dropped, alice's comment changes in place, and
+				// carol is new -- covering update, delete, and create in a
+				// single diff.
+				Config: acctest.ProviderConfig(srv.URL) + fmt.Sprintf(`
+resource "webarena_indigo_sshkey_set" "test" {
+  keys = [
+    { name = "alice", sshkey = %q, comment = "updated" },
+    { name = "carol", sshkey = %q },
+  ]
+}
+`, testRSAKeyA, testEd25519KeyB),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("webarena_indigo_sshkey_set.test", "ids.alice"),
+					resource.TestCheckResourceAttrSet("webarena_indigo_sshkey_set.test", "ids.carol"),
+					resource.TestCheckNoResourceAttr("webarena_indigo_sshkey_set.test", "ids.bob"),
+				),
+			},
+		},
+	})
+}
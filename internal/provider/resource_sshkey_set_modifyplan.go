@@ -0,0 +1,116 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/hashicorp/go-hclog"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/hakadoriya/terraform-provider-webarena/internal/indigo"
+)
+
+var _ resource.ResourceWithModifyPlan = &sshKeySetResource{}
+
+// privateKeyBatchPlan is the key under which sshKeySetBatchPlan is stashed in
+// private state, namespaced to this resource type to avoid colliding with
+// any other private state another ModifyPlan hook on this resource might add
+// later.
+const privateKeyBatchPlan = "webarena_indigo_sshkey_set.batch_plan"
+
+// sshKeySetBatchPlan records, by name, which set members a plan will create,
+// update, or delete. ModifyPlan computes and stores it so Update can log the
+// batch shape it's about to execute without recomputing the diff against
+// state a second time.
+type sshKeySetBatchPlan struct {
+	ToCreate []string `json:"to_create,omitempty"`
+	ToUpdate []string `json:"to_update,omitempty"`
+	ToDelete []string `json:"to_delete,omitempty"`
+}
+
+func (r *sshKeySetResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		// Destroy plan; nothing to batch.
+		return
+	}
+
+	var plan sshKeySetResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	entries, diags := plan.entries(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	wanted := map[string]bool{}
+	for _, entry := range entries {
+		wanted[entry.Name.ValueString()] = true
+	}
+
+	priorIDs := map[string]string{}
+	if !req.State.Raw.IsNull() {
+		var state sshKeySetResourceModel
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		resp.Diagnostics.Append(state.IDs.ElementsAs(ctx, &priorIDs, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	batch := sshKeySetBatchPlan{}
+	for name := range wanted {
+		if _, ok := priorIDs[name]; ok {
+			batch.ToUpdate = append(batch.ToUpdate, name)
+		} else {
+			batch.ToCreate = append(batch.ToCreate, name)
+		}
+	}
+	for name := range priorIDs {
+		if !wanted[name] {
+			batch.ToDelete = append(batch.ToDelete, name)
+		}
+	}
+
+	raw, err := json.Marshal(batch)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to Record Batch Plan", err.Error())
+		return
+	}
+	resp.Diagnostics.Append(resp.Private.SetKey(ctx, privateKeyBatchPlan, raw)...)
+}
+
+// logBatchPlan logs the batch plan ModifyPlan recorded in private state
+// (raw, as returned by Private.GetKey), so a failure partway through
+// Update's per-member loop can be correlated against the batch it was
+// attempting. Create has no equivalent call: the framework never threads
+// ModifyPlan's planned private state into CreateRequest, only into
+// UpdateRequest. A nil/empty raw (e.g. state predates this private state
+// key) is silently ignored. When client.LogLevel is set, logging uses a
+// dedicated subsystem at that level instead of deferring to TF_LOG.
+func logBatchPlan(ctx context.Context, client *indigo.Client, raw []byte) {
+	if len(raw) == 0 {
+		return
+	}
+	var batch sshKeySetBatchPlan
+	if err := json.Unmarshal(raw, &batch); err != nil {
+		return
+	}
+	fields := map[string]any{
+		"to_create": batch.ToCreate,
+		"to_update": batch.ToUpdate,
+		"to_delete": batch.ToDelete,
+	}
+	if client != nil && client.LogLevel != "" {
+		ctx = tflog.NewSubsystem(ctx, "sshkey_set", tflog.WithLevel(hclog.LevelFromString(client.LogLevel)))
+		tflog.SubsystemDebug(ctx, "sshkey_set", "applying sshkey set batch plan", fields)
+		return
+	}
+	tflog.Debug(ctx, "applying sshkey set batch plan", fields)
+}
@@ -0,0 +1,112 @@
+package provider_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/hakadoriya/terraform-provider-webarena/internal/provider/acctest"
+)
+
+// statusIgnoringSSHKeyServer is a minimal fake Indigo API that, unlike
+// FakeSSHKeyHandler, ignores any status change requested via an update --
+// simulating an API that silently fails to apply a requested state
+// transition -- so a test can confirm that's reported as an apply error
+// instead of succeeding with state that silently drifted from what was
+// requested.
+func statusIgnoringSSHKeyServer(t *testing.T) *httptest.Server {
+	t.Helper()
+
+	var mu sync.Mutex
+	var nextID int
+	keys := map[string]map[string]any{}
+
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		path := strings.Trim(strings.TrimPrefix(r.URL.Path, "/sshkeys"), "/")
+		w.Header().Set("Content-Type", "application/json")
+
+		switch {
+		case r.Method == http.MethodPost && path == "":
+			var body map[string]any
+			_ = json.NewDecoder(r.Body).Decode(&body)
+			nextID++
+			id := strconv.Itoa(nextID)
+			body["id"] = id
+			if _, ok := body["status"]; !ok {
+				body["status"] = "ACTIVE"
+			}
+			keys[id] = body
+			_ = json.NewEncoder(w).Encode(body)
+		case r.Method == http.MethodPut && path != "":
+			key, ok := keys[path]
+			if !ok {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			var body map[string]any
+			_ = json.NewDecoder(r.Body).Decode(&body)
+			requestedStatus := key["status"]
+			for k, v := range body {
+				key[k] = v
+			}
+			// The one deviation from FakeSSHKeyHandler.update: whatever
+			// status was requested is discarded, so the API's response
+			// always reports the key's status as unchanged.
+			key["status"] = requestedStatus
+			keys[path] = key
+			_ = json.NewEncoder(w).Encode(key)
+		case r.Method == http.MethodGet && path != "":
+			key, ok := keys[path]
+			if !ok {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			_ = json.NewEncoder(w).Encode(key)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+}
+
+// TestAccSSHKeyResource_UpdateStatusMismatchErrors confirms that when the
+// Indigo API doesn't actually apply a requested status transition, Update
+// fails the apply instead of silently persisting the stale status as if the
+// request had succeeded.
+func TestAccSSHKeyResource_UpdateStatusMismatchErrors(t *testing.T) {
+	srv := statusIgnoringSSHKeyServer(t)
+	defer srv.Close()
+
+	config := func(status string) string {
+		return acctest.ProviderConfig(srv.URL) + fmt.Sprintf(`
+resource "webarena_indigo_sshkey" "test" {
+  name   = "acctest-status-mismatch"
+  sshkey = %q
+  status = %q
+}
+`, testRSAKeyA, status)
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: config("ACTIVE"),
+			},
+			{
+				Config:      config("DEACTIVE"),
+				ExpectError: regexp.MustCompile("Status Did Not Match Request"),
+			},
+		},
+	})
+}
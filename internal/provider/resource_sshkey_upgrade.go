@@ -0,0 +1,151 @@
+package provider
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ resource.ResourceWithUpgradeState = &sshKeyResource{}
+
+// sshKeyResourceModelV0 is the state shape from before id was changed to a
+// string. Early Indigo API responses echoed id back as a bare JSON number,
+// and the first cut of this resource stored it as one; later API responses
+// started quoting it, which a schema.Int64Attribute can't decode without
+// erroring. Attributes the current schema has added since V0 (expires_at,
+// protect_last_active, min_rsa_bits, report_drift, deactivate_on_destroy,
+// warn_on_key_truncation, fail_on_existing_name) have no V0 counterpart and
+// start out null after the upgrade; every other attribute carries over as is.
+type sshKeyResourceModelV0 struct {
+	ID                       types.Int64  `tfsdk:"id"`
+	Name                     types.String `tfsdk:"name"`
+	SSHKey                   types.String `tfsdk:"sshkey"`
+	SSHKeyWO                 types.String `tfsdk:"sshkey_wo"`
+	SSHKeyWOVersion          types.String `tfsdk:"sshkey_wo_version"`
+	SSHKeyOriginal           types.String `tfsdk:"sshkey_original"`
+	Comment                  types.String `tfsdk:"comment"`
+	Tags                     types.Map    `tfsdk:"tags"`
+	Fingerprint              types.String `tfsdk:"fingerprint"`
+	Status                   types.String `tfsdk:"status"`
+	Upsert                   types.Bool   `tfsdk:"upsert"`
+	CreatedAt                types.String `tfsdk:"created_at"`
+	UpdatedAt                types.String `tfsdk:"updated_at"`
+	TreatDeactiveAsDeleted   types.Bool   `tfsdk:"treat_deactive_as_deleted"`
+	RequireEd25519           types.Bool   `tfsdk:"require_ed25519"`
+	RecreateIfDeactiveOnRead types.Bool   `tfsdk:"recreate_if_deactive_on_read"`
+}
+
+// UpgradeState migrates state written by a version of this resource where id
+// was a number, to the current string-typed id.
+func (r *sshKeyResource) UpgradeState(_ context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{
+		0: {
+			PriorSchema: &schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"id": schema.Int64Attribute{
+						Computed:    true,
+						Description: "Identifier assigned by the Indigo API.",
+					},
+					"name": schema.StringAttribute{
+						Required:    true,
+						Description: "Name used to identify the key in the Indigo control panel.",
+					},
+					"sshkey": schema.StringAttribute{
+						Optional:    true,
+						Description: "The public key material, e.g. the contents of an id_ed25519.pub file. Persisted to state; use sshkey_wo instead to keep the key material out of state.",
+					},
+					"sshkey_wo": schema.StringAttribute{
+						Optional:    true,
+						WriteOnly:   true,
+						Description: "Write-only variant of sshkey: used for create/update but never persisted to state. Drift is instead detected via fingerprint. Bump sshkey_wo_version to force Terraform to apply a new value.",
+					},
+					"sshkey_wo_version": schema.StringAttribute{
+						Optional:    true,
+						Description: "Arbitrary value that, when changed, signals that sshkey_wo has changed and should be re-applied. Required when using sshkey_wo, since write-only values are not available to detect drift.",
+					},
+					"sshkey_original": schema.StringAttribute{
+						Computed:    true,
+						Description: "The exact key material last submitted to the Indigo API, captured before any normalization the API applies when echoing sshkey back.",
+					},
+					"comment": schema.StringAttribute{
+						Optional:    true,
+						Computed:    true,
+						Default:     stringdefault.StaticString(sshKeyDefaultComment),
+						Description: "Comment to associate with the key.",
+					},
+					"tags": schema.MapAttribute{
+						Optional:    true,
+						ElementType: types.StringType,
+						Description: "Arbitrary key/value labels to associate with the key in the Indigo control panel.",
+					},
+					"fingerprint": schema.StringAttribute{
+						Computed:    true,
+						Description: "Fingerprint of the registered public key, as reported by the Indigo API.",
+					},
+					"status": schema.StringAttribute{
+						Optional:    true,
+						Computed:    true,
+						Default:     stringdefault.StaticString(sshKeyDefaultStatus),
+						Description: "Desired status of the key (ACTIVE or DEACTIVE).",
+					},
+					"upsert": schema.BoolAttribute{
+						Optional:    true,
+						Description: "When true, Create first checks for an existing key with the same name and adopts it via update instead of failing on a name conflict.",
+					},
+					"created_at": schema.StringAttribute{
+						Computed:    true,
+						Description: "Timestamp the key was created, in UTC RFC3339.",
+					},
+					"updated_at": schema.StringAttribute{
+						Computed:    true,
+						Description: "Timestamp the key was last updated, in UTC RFC3339.",
+					},
+					"treat_deactive_as_deleted": schema.BoolAttribute{
+						Optional:    true,
+						Description: "When true, Read treats a key the API reports as DEACTIVE as no longer present and removes it from state.",
+					},
+					"require_ed25519": schema.BoolAttribute{
+						Optional:    true,
+						Description: "When true, ValidateConfig rejects any key whose type isn't ssh-ed25519.",
+					},
+					"recreate_if_deactive_on_read": schema.BoolAttribute{
+						Optional:    true,
+						Description: "When true, ModifyPlan forces a replace instead of an in-place reactivation whenever Read finds the key DEACTIVE.",
+					},
+				},
+			},
+			StateUpgrader: func(ctx context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
+				var priorState sshKeyResourceModelV0
+				resp.Diagnostics.Append(req.State.Get(ctx, &priorState)...)
+				if resp.Diagnostics.HasError() {
+					return
+				}
+
+				upgraded := sshKeyResourceModel{
+					ID:                       types.StringValue(strconv.FormatInt(priorState.ID.ValueInt64(), 10)),
+					Name:                     priorState.Name,
+					SSHKey:                   priorState.SSHKey,
+					SSHKeyWO:                 priorState.SSHKeyWO,
+					SSHKeyWOVersion:          priorState.SSHKeyWOVersion,
+					SSHKeyOriginal:           priorState.SSHKeyOriginal,
+					Comment:                  priorState.Comment,
+					Tags:                     priorState.Tags,
+					Fingerprint:              priorState.Fingerprint,
+					Status:                   priorState.Status,
+					Upsert:                   priorState.Upsert,
+					CreatedAt:                priorState.CreatedAt,
+					UpdatedAt:                priorState.UpdatedAt,
+					TreatDeactiveAsDeleted:   priorState.TreatDeactiveAsDeleted,
+					RequireEd25519:           priorState.RequireEd25519,
+					RecreateIfDeactiveOnRead: priorState.RecreateIfDeactiveOnRead,
+				}
+
+				resp.Diagnostics.Append(resp.State.Set(ctx, &upgraded)...)
+			},
+		},
+	}
+}
@@ -0,0 +1,84 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// TestSSHKeyResourceUpgradeState_V0ConvertsIntIDToString exercises the V0
+// StateUpgrader directly with a synthetic prior state carrying a numeric id,
+// confirming it lands as the equivalent string id with every other attribute
+// carried over unchanged.
+func TestSSHKeyResourceUpgradeState_V0ConvertsIntIDToString(t *testing.T) {
+	ctx := context.Background()
+	r := &sshKeyResource{}
+
+	upgraders := r.UpgradeState(ctx)
+	upgrader, ok := upgraders[0]
+	if !ok {
+		t.Fatal("UpgradeState has no entry for schema version 0")
+	}
+
+	priorType := upgrader.PriorSchema.Type().TerraformType(ctx)
+	priorValue := tftypes.NewValue(priorType, map[string]tftypes.Value{
+		"id":                           tftypes.NewValue(tftypes.Number, 123),
+		"name":                         tftypes.NewValue(tftypes.String, "legacy-key"),
+		"sshkey":                       tftypes.NewValue(tftypes.String, "ssh-rsa AAAA legacy"),
+		"sshkey_wo":                    tftypes.NewValue(tftypes.String, nil),
+		"sshkey_wo_version":            tftypes.NewValue(tftypes.String, nil),
+		"sshkey_original":              tftypes.NewValue(tftypes.String, "ssh-rsa AAAA legacy"),
+		"comment":                      tftypes.NewValue(tftypes.String, "migrated"),
+		"tags":                         tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
+		"fingerprint":                  tftypes.NewValue(tftypes.String, "SHA256:abc"),
+		"status":                       tftypes.NewValue(tftypes.String, "ACTIVE"),
+		"upsert":                       tftypes.NewValue(tftypes.Bool, false),
+		"created_at":                   tftypes.NewValue(tftypes.String, "2023-11-14T22:13:21Z"),
+		"updated_at":                   tftypes.NewValue(tftypes.String, "2023-11-14T22:13:21Z"),
+		"treat_deactive_as_deleted":    tftypes.NewValue(tftypes.Bool, false),
+		"require_ed25519":              tftypes.NewValue(tftypes.Bool, false),
+		"recreate_if_deactive_on_read": tftypes.NewValue(tftypes.Bool, false),
+	})
+
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+	if schemaResp.Diagnostics.HasError() {
+		t.Fatalf("building schema: %v", schemaResp.Diagnostics)
+	}
+
+	req := resource.UpgradeStateRequest{
+		State: &tfsdk.State{
+			Schema: *upgrader.PriorSchema,
+			Raw:    priorValue,
+		},
+	}
+	resp := &resource.UpgradeStateResponse{
+		State: tfsdk.State{
+			Schema: schemaResp.Schema,
+			Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
+		},
+	}
+
+	upgrader.StateUpgrader(ctx, req, resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("StateUpgrader returned unexpected diagnostics: %v", resp.Diagnostics)
+	}
+
+	var got sshKeyResourceModel
+	if diags := resp.State.Get(ctx, &got); diags.HasError() {
+		t.Fatalf("reading back upgraded state: %v", diags)
+	}
+
+	if got.ID.ValueString() != "123" {
+		t.Errorf("ID = %q, want %q", got.ID.ValueString(), "123")
+	}
+	if got.Name.ValueString() != "legacy-key" {
+		t.Errorf("Name = %q, want %q", got.Name.ValueString(), "legacy-key")
+	}
+	if got.Status.ValueString() != "ACTIVE" {
+		t.Errorf("Status = %q, want %q", got.Status.ValueString(), "ACTIVE")
+	}
+}
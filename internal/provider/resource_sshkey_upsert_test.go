@@ -0,0 +1,60 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hakadoriya/terraform-provider-webarena/internal/indigo"
+)
+
+const (
+	upsertTestRSAKey = "ssh-rsa AAAAB3NzaC1yc2EAAAADAQABAAABAQDfpRZZ/H6/RAlEZWJ248F8YZkFcuI+q3o6oVSo/SQgGEnb3vfs4kUTZXykkZGOJ/H9BQPqyn3F+tsBwUDAZidPcbZpNVdyIduHPqObKTO/wlM+3QfExRqb4KvHY1Gmi075LZVkd1t7p/xH05PFj/ynjjsl7s8u1BJ19Cyi8twVcdWJWoMJk1dG7k/xm9uQUMkmicZfMuJNPDMc0oKCeNhj2Sn+BR1SNnv4HrcBHOQ63kBKgdJOYKPhbh6oMfu2sKXp4r8z22YhN2a1NPrvve83/o1xltV6dUJq6KM5qWSNFYV8RL4WxwVwaJjuEdcjl6Rgd4MOZ2XwD1XS1QNfuJuh test-key-a"
+	upsertTestRSAFP  = "SHA256:KDkS7f14gWODgoakJQPuIkBr56IBXBHgdcxaOZq9R6U"
+
+	upsertTestEd25519Key = "ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIErq1/s/kSwuJ45h8lbAmOvxenAO1lYDKvYjdibSmR7z test-key-b"
+)
+
+// TestSSHKeyBodiesMatch exercises the comparison Create's upsert path relies
+// on to decide whether an existing same-name key is the same key (safe to
+// adopt) or an unrelated one (should error instead of silently overwriting
+// it).
+func TestSSHKeyBodiesMatch(t *testing.T) {
+	tests := map[string]struct {
+		existing *indigo.SSHKey
+		newBody  string
+		want     bool
+	}{
+		"same key, fingerprint known": {
+			existing: &indigo.SSHKey{SSHKey: upsertTestRSAKey, Fingerprint: upsertTestRSAFP},
+			newBody:  upsertTestRSAKey,
+			want:     true,
+		},
+		"same key, re-commented": {
+			existing: &indigo.SSHKey{SSHKey: upsertTestRSAKey, Fingerprint: upsertTestRSAFP},
+			newBody:  upsertTestRSAKey + "-different-comment",
+			want:     true, // comment carries no key material, so fingerprint still matches
+		},
+		"different key entirely": {
+			existing: &indigo.SSHKey{SSHKey: upsertTestRSAKey, Fingerprint: upsertTestRSAFP},
+			newBody:  upsertTestEd25519Key,
+			want:     false,
+		},
+		"unparseable new body falls back to trimmed string comparison, equal": {
+			existing: &indigo.SSHKey{SSHKey: "not-a-real-key", Fingerprint: ""},
+			newBody:  "  not-a-real-key  ",
+			want:     true,
+		},
+		"unparseable new body falls back to trimmed string comparison, different": {
+			existing: &indigo.SSHKey{SSHKey: "not-a-real-key", Fingerprint: ""},
+			newBody:  "also-not-a-real-key",
+			want:     false,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			if got := sshKeyBodiesMatch(tc.existing, tc.newBody); got != tc.want {
+				t.Fatalf("sshKeyBodiesMatch(...) = %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,117 @@
+package provider
+
+import (
+	"context"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/hakadoriya/terraform-provider-webarena/internal/indigo"
+)
+
+var _ resource.ConfigValidator = &sshKeyNotBlankValidator{}
+
+// sshKeyNotBlankValidator rejects an sshkey/sshkey_wo value that is empty
+// once leading/trailing whitespace is stripped. schema.StringAttribute alone
+// can't express this: a config value of "   " passes Required/Optional
+// checks but would register a key with no actual public key material.
+type sshKeyNotBlankValidator struct{}
+
+func (v *sshKeyNotBlankValidator) Description(_ context.Context) string {
+	return "sshkey and sshkey_wo, when set, must not be empty or all whitespace."
+}
+
+func (v *sshKeyNotBlankValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v *sshKeyNotBlankValidator) ValidateResource(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config sshKeyResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	v.checkKey(resp, path.Root("sshkey"), config.SSHKey.ValueString(), config.SSHKey.IsNull(), config.SSHKey.IsUnknown())
+	v.checkKey(resp, path.Root("sshkey_wo"), config.SSHKeyWO.ValueString(), config.SSHKeyWO.IsNull(), config.SSHKeyWO.IsUnknown())
+}
+
+// checkKey rejects a blank value outright, and otherwise confirms the value
+// parses as a real OpenSSH public key via indigo.Fingerprint -- catching a
+// pasted private key, a truncated copy/paste, or similar garbage before it
+// ever reaches the Indigo API.
+func (v *sshKeyNotBlankValidator) checkKey(resp *resource.ValidateConfigResponse, attr path.Path, raw string, isNull, isUnknown bool) {
+	if isNull || isUnknown {
+		return
+	}
+
+	if strings.TrimSpace(raw) == "" {
+		resp.Diagnostics.AddAttributeError(attr, "Blank SSH Key", attr.String()+" must not be empty or consist only of whitespace.")
+		return
+	}
+
+	if _, err := indigo.Fingerprint(raw); err != nil {
+		resp.Diagnostics.AddAttributeError(attr, "Invalid SSH Key", attr.String()+" does not parse as an OpenSSH public key: "+err.Error())
+	}
+}
+
+var _ resource.ConfigValidator = &sshKeyNameNotSwappedValidator{}
+
+// sshKeyNameNotSwappedValidator catches the classic copy/paste mistake of
+// swapping name and sshkey in a resource block: name ends up holding the
+// public key body and sshkey ends up holding what was meant to be the
+// display name. Both attributes are plain strings, so nothing in the schema
+// itself would catch this -- the resource would apply successfully and
+// register a key with a garbled name.
+type sshKeyNameNotSwappedValidator struct{}
+
+func (v *sshKeyNameNotSwappedValidator) Description(_ context.Context) string {
+	return "name must not look like an SSH public key, and sshkey/sshkey_wo must not look like a plain name."
+}
+
+func (v *sshKeyNameNotSwappedValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v *sshKeyNameNotSwappedValidator) ValidateResource(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config sshKeyResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !config.Name.IsNull() && !config.Name.IsUnknown() {
+		if _, err := indigo.Fingerprint(config.Name.ValueString()); err == nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("name"),
+				"name Looks Like an SSH Key",
+				"name parses as an OpenSSH public key. This usually means name and sshkey (or sshkey_wo) were swapped.",
+			)
+		}
+	}
+
+	for _, attr := range []struct {
+		name string
+		v    types.String
+	}{
+		{"sshkey", config.SSHKey},
+		{"sshkey_wo", config.SSHKeyWO},
+	} {
+		if attr.v.IsNull() || attr.v.IsUnknown() {
+			continue
+		}
+		raw := strings.TrimSpace(attr.v.ValueString())
+		if raw == "" || strings.HasPrefix(raw, "ssh-") || strings.HasPrefix(raw, "ecdsa-") {
+			continue
+		}
+		if !strings.Contains(raw, " ") {
+			resp.Diagnostics.AddAttributeError(
+				path.Root(attr.name),
+				attr.name+" Looks Like a Name",
+				attr.name+" does not look like an OpenSSH public key (no key-type prefix or embedded whitespace). This usually means name and "+attr.name+" were swapped.",
+			)
+		}
+	}
+}
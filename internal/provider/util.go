@@ -0,0 +1,111 @@
+package provider
+
+import (
+	"fmt"
+	"net/url"
+	"os"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// envOr returns the value of the named environment variable, falling back
+// to fallback when it is unset or empty.
+func envOr(name, fallback string) string {
+	if v := os.Getenv(name); v != "" {
+		return v
+	}
+	return fallback
+}
+
+// firstNonEmpty returns the first of vs that is non-empty, or "" if all are.
+func firstNonEmpty(vs ...string) string {
+	for _, v := range vs {
+		if v != "" {
+			return v
+		}
+	}
+	return ""
+}
+
+// descf interpolates schema.Attribute.Description strings with values taken
+// from the same constants the code actually enforces (a default, an env var
+// name, an enum of valid values), instead of hand-typing them into prose.
+// tfplugindocs renders these strings verbatim into the generated docs, so a
+// literal that drifts from the real default is a silent documentation bug;
+// routing it through fmt.Sprintf keeps the two in sync by construction.
+func descf(format string, args ...any) string {
+	return fmt.Sprintf(format, args...)
+}
+
+// stringValuesEqual reports whether a and b are both concretely known and
+// equal. An Unknown value (e.g. interpolated from another resource's
+// not-yet-applied attribute) never compares equal to anything via
+// ValueString() alone, since that silently coerces Unknown to "" -- callers
+// deciding whether something changed should use this instead of comparing
+// ValueString() directly.
+func stringValuesEqual(a, b types.String) bool {
+	if a.IsUnknown() || b.IsUnknown() {
+		return false
+	}
+	return a.ValueString() == b.ValueString()
+}
+
+// envFileOr behaves like envOr, but first checks whether name+"_FILE" is
+// set. If so, the value is read from the file it names instead -- the
+// Docker/Kubernetes secrets convention of mounting a credential at a path
+// and pointing at it via an env var, so the secret itself never has to sit
+// in the process environment (visible via /proc/<pid>/environ, inherited by
+// child processes, etc). name+"_FILE" takes precedence over name itself.
+func envFileOr(name, fallback string) (string, error) {
+	if filePath := os.Getenv(name + "_FILE"); filePath != "" {
+		data, err := os.ReadFile(filePath)
+		if err != nil {
+			return "", fmt.Errorf("reading %s (from %s_FILE): %w", filePath, name, err)
+		}
+		return strings.TrimSpace(string(data)), nil
+	}
+	return envOr(name, fallback), nil
+}
+
+// validateEndpoint checks endpoint for the malformed-input cases that
+// produce a confusing error only once the client actually tries to make a
+// request: a missing scheme, a non-HTTP(S) scheme, a missing host, or a
+// query/fragment accidentally left on the URL. Each case gets its own
+// message naming exactly what's wrong, instead of a single generic "invalid
+// endpoint" that makes the user re-derive which part is broken.
+func validateEndpoint(endpoint string) error {
+	u, err := url.Parse(endpoint)
+	if err != nil {
+		return fmt.Errorf("endpoint %q is not a valid URL: %w", endpoint, err)
+	}
+	if u.Scheme == "" {
+		return fmt.Errorf("endpoint %q is missing a scheme (expected it to start with https://)", endpoint)
+	}
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return fmt.Errorf("endpoint %q has an unsupported scheme %q (expected http or https)", endpoint, u.Scheme)
+	}
+	if u.Host == "" {
+		return fmt.Errorf("endpoint %q is missing a host", endpoint)
+	}
+	if u.RawQuery != "" || u.Fragment != "" {
+		return fmt.Errorf("endpoint %q must not include a query string or fragment", endpoint)
+	}
+	return nil
+}
+
+// mergeDefaultTags returns a new map combining defaults with tags, with tags
+// taking precedence on key conflicts. Either argument may be nil.
+func mergeDefaultTags(defaults, tags map[string]string) map[string]string {
+	if len(defaults) == 0 {
+		return tags
+	}
+	merged := make(map[string]string, len(defaults)+len(tags))
+	for k, v := range defaults {
+		merged[k] = v
+	}
+	for k, v := range tags {
+		merged[k] = v
+	}
+	return merged
+}
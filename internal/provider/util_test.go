@@ -0,0 +1,103 @@
+package provider
+
+import (
+	"reflect"
+	"testing"
+)
+
+// TestValidateEndpoint exercises each malformed-input case validateEndpoint
+// is meant to catch before the client ever makes a request, plus the
+// well-formed case it must let through.
+func TestValidateEndpoint(t *testing.T) {
+	tests := map[string]struct {
+		endpoint string
+		wantErr  bool
+	}{
+		"valid https endpoint": {
+			endpoint: "https://indigo.example.com",
+			wantErr:  false,
+		},
+		"valid http endpoint": {
+			endpoint: "http://indigo.example.com",
+			wantErr:  false,
+		},
+		"missing scheme": {
+			endpoint: "indigo.example.com",
+			wantErr:  true,
+		},
+		"unsupported scheme": {
+			endpoint: "ftp://indigo.example.com",
+			wantErr:  true,
+		},
+		"missing host": {
+			endpoint: "https://",
+			wantErr:  true,
+		},
+		"query string present": {
+			endpoint: "https://indigo.example.com?foo=bar",
+			wantErr:  true,
+		},
+		"fragment present": {
+			endpoint: "https://indigo.example.com#section",
+			wantErr:  true,
+		},
+		"not a valid URL": {
+			endpoint: "://not-a-url",
+			wantErr:  true,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := validateEndpoint(tc.endpoint)
+			if (err != nil) != tc.wantErr {
+				t.Fatalf("validateEndpoint(%q) error = %v, wantErr %v", tc.endpoint, err, tc.wantErr)
+			}
+		})
+	}
+}
+
+// TestMergeDefaultTags confirms tags takes precedence over defaults on key
+// conflicts, and that either argument being nil/empty is handled without
+// panicking.
+func TestMergeDefaultTags(t *testing.T) {
+	tests := map[string]struct {
+		defaults map[string]string
+		tags     map[string]string
+		want     map[string]string
+	}{
+		"no defaults": {
+			defaults: nil,
+			tags:     map[string]string{"env": "prod"},
+			want:     map[string]string{"env": "prod"},
+		},
+		"no tags": {
+			defaults: map[string]string{"team": "infra"},
+			tags:     nil,
+			want:     map[string]string{"team": "infra"},
+		},
+		"disjoint keys merge": {
+			defaults: map[string]string{"team": "infra"},
+			tags:     map[string]string{"env": "prod"},
+			want:     map[string]string{"team": "infra", "env": "prod"},
+		},
+		"tags wins on key conflict": {
+			defaults: map[string]string{"env": "staging"},
+			tags:     map[string]string{"env": "prod"},
+			want:     map[string]string{"env": "prod"},
+		},
+		"both nil": {
+			defaults: nil,
+			tags:     nil,
+			want:     nil,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			if got := mergeDefaultTags(tc.defaults, tc.tags); !reflect.DeepEqual(got, tc.want) {
+				t.Fatalf("mergeDefaultTags(%v, %v) = %v, want %v", tc.defaults, tc.tags, got, tc.want)
+			}
+		})
+	}
+}
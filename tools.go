@@ -0,0 +1,10 @@
+//go:build tools
+
+// Package tools pins the doc-generation tool invoked by main.go's go:generate
+// directive as a real module dependency, so `go generate` works with nothing
+// beyond the module's own go.mod/go.sum.
+package tools
+
+import (
+	_ "github.com/hashicorp/terraform-plugin-docs/cmd/tfplugindocs"
+)